@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The VCR record/replay modes, selected with the IBM_VCR_MODE environment
+// variable. In record mode the API calls of the tests run with VCRTest are
+// saved to a cassette; in replay mode they are answered from the cassette so
+// that the tests run without an IBM Cloud account.
+const (
+	VCRModeRecord = "record"
+	VCRModeReplay = "replay"
+)
+
+// VCRCassetteDir is the directory of the cassettes, relative to the package of
+// the test, unless IBM_VCR_CASSETTE_DIR is set.
+const VCRCassetteDir = "testdata/cassettes"
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody string      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        string      `json:"body,omitempty"`
+}
+
+// Cassette holds the interactions recorded by a test.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// vcrRecorder is the transport recording or replaying the API calls of the
+// test being run.
+type vcrRecorder struct {
+	mu        sync.Mutex
+	mode      string
+	cassette  *Cassette
+	used      map[int]bool
+	transport http.RoundTripper
+}
+
+var vcr = &vcrRecorder{}
+
+func init() {
+	vcr.mode = VCRMode()
+	if vcr.mode == "" {
+		return
+	}
+	// The IAM authenticators and the Bluemix session fall back to the default
+	// transport, while the IBM SDK service clients have their own HTTP client.
+	vcr.transport = http.DefaultTransport
+	http.DefaultTransport = vcr
+	conns.TestTransport = vcr
+}
+
+// VCRMode returns the record/replay mode, "" when disabled.
+func VCRMode() string {
+	switch mode := strings.ToLower(os.Getenv("IBM_VCR_MODE")); mode {
+	case VCRModeRecord, VCRModeReplay:
+		return mode
+	default:
+		return ""
+	}
+}
+
+func cassettePath(t *testing.T) string {
+	dir := os.Getenv("IBM_VCR_CASSETTE_DIR")
+	if dir == "" {
+		dir = VCRCassetteDir
+	}
+	return filepath.Join(dir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// VCRTest runs an acceptance test through the VCR recorder. Without
+// IBM_VCR_MODE it is the same as resource.Test. In replay mode the test runs
+// without credentials nor TF_ACC, and is skipped if it has no cassette.
+//
+// Requests are matched on their method and URL, in the recorded order, so the
+// configuration of a replayed test must not use random names.
+func VCRTest(t *testing.T, c resource.TestCase) {
+	if vcr.mode == "" {
+		resource.Test(t, c)
+		return
+	}
+
+	path := cassettePath(t)
+	cassette := &Cassette{}
+	if vcr.mode == VCRModeReplay {
+		cassette = loadCassette(t, path)
+		t.Setenv("TF_ACC", "1")
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				t.Setenv(env, "replay")
+			}
+		}
+		c.PreCheck = nil
+	}
+
+	// Fresh providers, so that every cassette holds the IAM token requests.
+	c.Providers = nil
+	c.ProviderFactories = TestAccProviderFactories()
+
+	vcr.start(cassette)
+	defer func() {
+		vcr.stop()
+		if vcr.mode == VCRModeRecord && !t.Failed() {
+			if err := cassette.save(path); err != nil {
+				t.Errorf("saving cassette %s: %s", path, err)
+			}
+		}
+	}()
+	resource.Test(t, c)
+}
+
+// loadCassette reads the cassette at path, the test is skipped if there is none.
+func loadCassette(t *testing.T, path string) *Cassette {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Skipf("no cassette recorded at %s", path)
+	}
+	if err != nil {
+		t.Fatalf("reading cassette %s: %s", path, err)
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		t.Fatalf("reading cassette %s: %s", path, err)
+	}
+	return cassette
+}
+
+func (r *vcrRecorder) start(cassette *Cassette) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette = cassette
+	r.used = map[int]bool{}
+}
+
+func (r *vcrRecorder) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette = nil
+}
+
+func (r *vcrRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	cassette := r.cassette
+	r.mu.Unlock()
+	if cassette == nil {
+		if r.mode == VCRModeReplay {
+			return nil, fmt.Errorf("[ERROR] VCR replay: unexpected %s %s outside of a test", req.Method, req.URL.Redacted())
+		}
+		return r.transport.RoundTrip(req)
+	}
+	if r.mode == VCRModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+func (r *vcrRecorder) record(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	interaction := &Interaction{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestBody: RedactCassette(string(requestBody)),
+		StatusCode:  resp.StatusCode,
+		Header:      header,
+		Body:        RedactCassette(string(body)),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	}
+	return resp, nil
+}
+
+// replay answers req with the first unused interaction with the same method
+// and URL. Once they are all used the last one is repeated, as the number of
+// polling requests can differ between runs.
+func (r *vcrRecorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	url := req.URL.String()
+	var match *Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Method != req.Method || interaction.URL != url {
+			continue
+		}
+		match = interaction
+		if !r.used[i] {
+			r.used[i] = true
+			break
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("[ERROR] VCR replay: no recorded interaction for %s %s", req.Method, req.URL.Redacted())
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.StatusCode, http.StatusText(match.StatusCode)),
+		StatusCode:    match.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        match.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(match.Body)),
+		ContentLength: int64(len(match.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (c *Cassette) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+var (
+	jwtSignature   = regexp.MustCompile(`\b(eyJ[A-Za-z0-9_-]*\.eyJ[A-Za-z0-9_-]*)\.[A-Za-z0-9_-]+`)
+	accessTokenKey = regexp.MustCompile(`"access_token"(\s*):`)
+)
+
+// RedactCassette removes the secrets from a recorded body. Access tokens are
+// kept without their signature, as the provider reads the account from their
+// claims.
+func RedactCassette(body string) string {
+	body = jwtSignature.ReplaceAllString(body, "${1}.redacted")
+	body = accessTokenKey.ReplaceAllString(body, `"vcr_access_token"${1}:`)
+	body = conns.RedactSecrets(body)
+	return strings.ReplaceAll(body, `"vcr_access_token"`, `"access_token"`)
+}
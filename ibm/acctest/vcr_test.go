@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVCRReplay(t *testing.T) {
+	recorder := &vcrRecorder{mode: VCRModeReplay}
+	recorder.start(loadCassette(t, cassettePath(t)))
+	defer recorder.stop()
+
+	client, err := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+		URL:           "https://iam.cloud.ibm.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The transport is injected the same way as conns.TestTransport, under
+	// the retryable client of the SDK.
+	client.Service.SetHTTPClient(&http.Client{Transport: recorder})
+	client.Service.EnableRetries(1, time.Second)
+
+	// The last interaction is repeated once they are all used.
+	for _, expected := range []string{"NONE", "TOTP4ALL", "TOTP4ALL"} {
+		settings, response, err := client.GetAccountSettings(&iamidentityv1.GetAccountSettingsOptions{
+			AccountID: core.StringPtr("a1b2c3d4e5f60718293a4b5c6d7e8f90"),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Equal(t, "a1b2c3d4e5f60718293a4b5c6d7e8f90", *settings.AccountID)
+		assert.Equal(t, expected, *settings.Mfa)
+	}
+
+	_, _, err = client.GetAccountSettings(&iamidentityv1.GetAccountSettingsOptions{
+		AccountID: core.StringPtr("00000000000000000000000000000000"),
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "no recorded interaction")
+	}
+}
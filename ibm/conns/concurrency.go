@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var (
+	semaphoresMu sync.Mutex
+	semaphores   = map[string]chan struct{}{}
+)
+
+// serviceSemaphore returns the semaphore shared by all the clients of the
+// service reachable at host. Clients of the same API (e.g. VPC and VPC beta)
+// share the same limit.
+func serviceSemaphore(host string, limit int) chan struct{} {
+	key := fmt.Sprintf("%s/%d", host, limit)
+
+	semaphoresMu.Lock()
+	defer semaphoresMu.Unlock()
+	sem, ok := semaphores[key]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		semaphores[key] = sem
+	}
+	return sem
+}
+
+// limitingTransport limits the number of in-flight requests to a service.
+type limitingTransport struct {
+	sem       chan struct{}
+	transport gohttp.RoundTripper
+}
+
+func (t *limitingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+	return t.transport.RoundTrip(req)
+}
+
+// limitConcurrency wraps transport so that no more than MaxConcurrentRequests
+// requests are sent in parallel to the host of serviceURL. The transport is
+// returned as is when no limit is configured.
+func (c *Config) limitConcurrency(serviceURL string, transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if c.MaxConcurrentRequests <= 0 {
+		return transport
+	}
+	u, err := url.Parse(serviceURL)
+	if err != nil || u.Host == "" {
+		return transport
+	}
+	if transport == nil {
+		transport = gohttp.DefaultTransport
+	}
+	return &limitingTransport{
+		sem:       serviceSemaphore(u.Host, c.MaxConcurrentRequests),
+		transport: transport,
+	}
+}
+
+// wrapTransport adds the per service concurrency limit, the TRACE logging and
+// the fallback of the "auto" visibility to the transport of an IBM SDK service
+// client.
+func (c *Config) wrapTransport(service *core.BaseService, transport gohttp.RoundTripper) gohttp.RoundTripper {
+	return NewRedactingTransport(c.limitConcurrency(service.GetServiceURL(), c.withAutoEndpoints(transport)))
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*gohttp.Request) (*gohttp.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	return f(req)
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	transport := roundTripFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return &gohttp.Response{StatusCode: 200}, nil
+	})
+
+	c := &Config{MaxConcurrentRequests: 2}
+	limited := c.limitConcurrency("https://iam.cloud.ibm.com", transport)
+	// Clients of the same service share the limit.
+	other := c.limitConcurrency("https://iam.cloud.ibm.com/v1", transport)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(rt gohttp.RoundTripper) {
+			defer wg.Done()
+			req, _ := gohttp.NewRequest("GET", "https://iam.cloud.ibm.com/v1/apikeys", nil)
+			if _, err := rt.RoundTrip(req); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}([]gohttp.RoundTripper{limited, other}[i%2])
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 parallel requests, got %d", maxInFlight)
+	}
+}
+
+func TestLimitConcurrencyDisabled(t *testing.T) {
+	c := &Config{}
+	if transport := c.limitConcurrency("https://iam.cloud.ibm.com", gohttp.DefaultTransport); transport != gohttp.DefaultTransport {
+		t.Fatalf("expected the transport not to be wrapped without a limit")
+	}
+}
@@ -172,8 +172,10 @@ type Config struct {
 	// When sdk implements it we an expose them for expected behaviour
 	// https://github.com/softlayer/softlayer-go/issues/41
 	RetryCount int
-	// Constant Retry Delay for API calls
+	// Base Retry Delay for API calls, doubled on every attempt
 	RetryDelay time.Duration
+	// Upper bound for a single Retry Delay
+	RetryMaxDelay time.Duration
 
 	// FunctionNameSpace ...
 	FunctionNameSpace string
@@ -190,6 +192,24 @@ type Config struct {
 	// TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	// Compute resource token file, used with IAMTrustedProfileID
+	CRTokenFile string
+
+	// Maximum number of parallel requests sent to a service, 0 means unlimited
+	MaxConcurrentRequests int
+
+	// Warn about the resources which were deleted outside of Terraform
+	WarnOnMissingResources bool
+
+	// Check at plan time that the requested VPC profiles, images and zones are available
+	ValidateAvailability bool
+
+	// User tags attached to every resource that supports global tagging
+	DefaultTags []string
+
+	// Resource group ID of the resources which don't configure one
+	DefaultResourceGroupID string
+
 	// IAM Refresh Token
 	IAMRefreshToken string
 
@@ -197,6 +217,15 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Endpoints overrides the endpoint of individual services, keyed by service name
+	Endpoints map[string]string
+
+	// crAuthenticator is the authenticator shared by the clients when CRTokenFile is set
+	crAuthenticator *core.ContainerAuthenticator
+
+	// endpointProber detects the unreachable private endpoints when Visibility is "auto"
+	endpointProber *endpointProber
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -215,6 +244,10 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	DefaultResourceGroupID() string
+	WarnOnMissingResources() bool
+	ValidateAvailability() bool
+	DefaultTags() []string
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -311,364 +344,277 @@ type ClientSession interface {
 type clientSession struct {
 	session *Session
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	appidAPI *lazyClient[*appid.AppIDManagementV4]
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	apigatewayAPI *lazyClient[*apigateway.ApiGatewayControllerApiV1]
 
-	accountConfigErr     error
-	bmxAccountServiceAPI accountv2.AccountServiceAPI
+	bmxAccountServiceAPI *lazyClient[accountv2.AccountServiceAPI]
 
-	accountV1ConfigErr     error
-	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
+	bmxAccountv1ServiceAPI *lazyClient[accountv1.AccountServiceAPI]
 
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
-	csConfigErr  error
-	csServiceAPI containerv1.ContainerServiceAPI
+	defaultResourceGroupID string
+	warnOnMissingResources bool
+	validateAvailability   bool
+	defaultTags            []string
+	endpoints              map[string]string
+
+	csServiceAPI *lazyClient[containerv1.ContainerServiceAPI]
 
-	csv2ConfigErr  error
-	csv2ServiceAPI containerv2.ContainerServiceAPI
+	csv2ServiceAPI *lazyClient[containerv2.ContainerServiceAPI]
 
-	containerRegistryClientErr error
-	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
+	containerRegistryClient *lazyClient[*containerregistryv1.ContainerRegistryV1]
 
-	cfConfigErr  error
-	cfServiceAPI mccpv2.MccpServiceAPI
+	cfServiceAPI *lazyClient[mccpv2.MccpServiceAPI]
 
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
-	functionConfigErr error
-	functionClient    *whisk.Client
+	functionClient *lazyClient[*whisk.Client]
 
-	globalSearchConfigErr  error
-	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
+	globalSearchServiceAPI *lazyClient[globalsearchv2.GlobalSearchServiceAPI]
 
-	globalTaggingConfigErr  error
-	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
+	globalTaggingServiceAPI *lazyClient[globaltaggingv3.GlobalTaggingServiceAPI]
 
-	globalTaggingConfigErrV1  error
-	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
+	globalTaggingServiceAPIV1 *lazyClient[globaltaggingv1.GlobalTaggingV1]
 
-	globalSearchConfigErrV2  error
-	globalSearchServiceAPIV2 searchv2.GlobalSearchV2
+	globalSearchServiceAPIV2 *lazyClient[searchv2.GlobalSearchV2]
 
-	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
-	ibmCloudShellClientErr error
+	ibmCloudShellClient *lazyClient[*ibmcloudshellv1.IBMCloudShellV1]
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementAPI *lazyClient[usermanagementv2.UserManagementAPI]
 
-	icdConfigErr  error
-	icdServiceAPI icdv4.ICDServiceAPI
+	icdServiceAPI *lazyClient[icdv4.ICDServiceAPI]
 
-	cloudDatabasesClientErr error
-	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
+	cloudDatabasesClient *lazyClient[*clouddatabasesv5.CloudDatabasesV5]
 
-	resourceControllerConfigErr  error
-	resourceControllerServiceAPI controller.ResourceControllerAPI
+	resourceControllerServiceAPI *lazyClient[controller.ResourceControllerAPI]
 
-	resourceControllerConfigErrv2  error
-	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
+	resourceControllerServiceAPIv2 *lazyClient[controllerv2.ResourceControllerAPIV2]
 
-	resourceManagementConfigErrv2  error
-	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
+	resourceManagementServiceAPIv2 *lazyClient[managementv2.ResourceManagementAPIv2]
 
-	resourceCatalogConfigErr  error
-	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
+	resourceCatalogServiceAPI *lazyClient[catalog.ResourceCatalogAPI]
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiSession *lazyClient[*ibmpisession.IBMPISession]
 
-	kpErr error
-	kpAPI *kp.API
+	kpAPI *lazyClient[*kp.API]
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsAPI *lazyClient[*kp.API]
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	hpcsEndpointAPI *lazyClient[hpcs.HPCSV2]
 
-	ukoClient    *ukov4.UkoV4
-	ukoClientErr error
+	ukoClient *lazyClient[*ukov4.UkoV4]
 
-	pDNSClient *dns.DnsSvcsV1
-	pDNSErr    error
+	pDNSClient *lazyClient[*dns.DnsSvcsV1]
 
 	bluemixSessionErr error
 
-	pushServiceClient    *pushservicev1.PushServiceV1
-	pushServiceClientErr error
+	pushServiceClient *lazyClient[*pushservicev1.PushServiceV1]
 
-	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
-	eventNotificationsApiClientErr error
+	eventNotificationsApiClient *lazyClient[*eventnotificationsv1.EventNotificationsV1]
 
-	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
-	appConfigurationClientErr error
+	appConfigurationClient *lazyClient[*appconfigurationv1.AppConfigurationV1]
 
-	vpcErr     error
-	vpcAPI     *vpc.VpcV1
-	vpcbetaErr error
-	vpcBetaAPI *vpcbeta.VpcbetaV1
+	vpcAPI     *lazyClient[*vpc.VpcV1]
+	vpcBetaAPI *lazyClient[*vpcbeta.VpcbetaV1]
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directlinkAPI *lazyClient[*dl.DirectLinkV1]
+	dlProviderAPI *lazyClient[*dlProviderV2.DirectLinkProviderV2]
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigAPI *lazyClient[*cosconfig.ResourceConfigurationV1]
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitgatewayAPI *lazyClient[*tg.TransitGatewayApisV1]
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceAPI *lazyClient[functions.FunctionServiceAPI]
 
 	// CIS Zones
-	cisZonesErr      error
-	cisZonesV1Client *ciszonesv1.ZonesV1
+	cisZonesV1Client *lazyClient[*ciszonesv1.ZonesV1]
 
 	// CIS Alerts
-	cisAlertsClient *cisalertsv1.AlertsV1
-	cisAlertsErr    error
+	cisAlertsClient *lazyClient[*cisalertsv1.AlertsV1]
 
 	// CIS Rulesets
-	cisRulesetsClient *cisrulesetsv1.RulesetsV1
-	cisRulesetsErr    error
+	cisRulesetsClient *lazyClient[*cisrulesetsv1.RulesetsV1]
 
 	// CIS Authenticated Origin Pull
-	cisOriginAuthClient  *cisoriginpull.AuthenticatedOriginPullApiV1
-	cisOriginAuthPullErr error
+	cisOriginAuthClient *lazyClient[*cisoriginpull.AuthenticatedOriginPullApiV1]
 
 	// CIS dns service options
-	cisDNSErr           error
-	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
+	cisDNSRecordsClient *lazyClient[*cisdnsrecordsv1.DnsRecordsV1]
 
 	// CIS dns bulk service options
-	cisDNSBulkErr          error
-	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
+	cisDNSRecordBulkClient *lazyClient[*cisdnsbulkv1.DnsRecordBulkV1]
 
 	// CIS Global Load Balancer Pool service options
-	cisGLBPoolErr    error
-	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
+	cisGLBPoolClient *lazyClient[*cisglbpoolv0.GlobalLoadBalancerPoolsV0]
 
 	// CIS GLB service options
-	cisGLBErr    error
-	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
+	cisGLBClient *lazyClient[*cisglbv1.GlobalLoadBalancerV1]
 
 	// CIS GLB health check service options
-	cisGLBHealthCheckErr    error
-	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
+	cisGLBHealthCheckClient *lazyClient[*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1]
 
 	// CIS IP service options
-	cisIPErr    error
-	cisIPClient *cisipv1.CisIpApiV1
+	cisIPClient *lazyClient[*cisipv1.CisIpApiV1]
 
 	// CIS Zone Rate Limits service options
-	cisRLErr    error
-	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
+	cisRLClient *lazyClient[*cisratelimitv1.ZoneRateLimitsV1]
 
 	// CIS Page Rules service options
-	cisPageRuleErr    error
-	cisPageRuleClient *cispagerulev1.PageRuleApiV1
+	cisPageRuleClient *lazyClient[*cispagerulev1.PageRuleApiV1]
 
 	// CIS Edge Functions service options
-	cisEdgeFunctionErr    error
-	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
+	cisEdgeFunctionClient *lazyClient[*cisedgefunctionv1.EdgeFunctionsApiV1]
 
 	// CIS SSL certificate service options
-	cisSSLErr    error
-	cisSSLClient *cissslv1.SslCertificateApiV1
+	cisSSLClient *lazyClient[*cissslv1.SslCertificateApiV1]
 
 	// CIS WAF Package service options
-	cisWAFPackageErr    error
-	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
+	cisWAFPackageClient *lazyClient[*ciswafpackagev1.WafRulePackagesApiV1]
 
 	// CIS Zone Setting service options
-	cisDomainSettingsErr    error
-	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
+	cisDomainSettingsClient *lazyClient[*cisdomainsettingsv1.ZonesSettingsV1]
 
 	// CIS Routing service options
-	cisRoutingErr    error
-	cisRoutingClient *cisroutingv1.RoutingV1
+	cisRoutingClient *lazyClient[*cisroutingv1.RoutingV1]
 
 	// CIS WAF Group service options
-	cisWAFGroupErr    error
-	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
+	cisWAFGroupClient *lazyClient[*ciswafgroupv1.WafRuleGroupsApiV1]
 
 	// CIS Caching service options
-	cisCacheErr    error
-	cisCacheClient *ciscachev1.CachingApiV1
+	cisCacheClient *lazyClient[*ciscachev1.CachingApiV1]
 
 	// CIS Custom Pages service options
-	cisCustomPageErr    error
-	cisCustomPageClient *ciscustompagev1.CustomPagesV1
+	cisCustomPageClient *lazyClient[*ciscustompagev1.CustomPagesV1]
 
 	// CIS Firewall Access rule service option
-	cisAccessRuleErr    error
-	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
+	cisAccessRuleClient *lazyClient[*cisaccessrulev1.ZoneFirewallAccessRulesV1]
 
 	// CIS User Agent Blocking Rule service option
-	cisUARuleErr    error
-	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
+	cisUARuleClient *lazyClient[*cisuarulev1.UserAgentBlockingRulesV1]
 
 	// CIS Firewall Lockdwon Rule service option
-	cisLockdownErr    error
-	cisLockdownClient *cislockdownv1.ZoneLockdownV1
+	cisLockdownClient *lazyClient[*cislockdownv1.ZoneLockdownV1]
 
 	// CIS LogpushJobs service option
-	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
-	cisLogpushJobsErr    error
+	cisLogpushJobsClient *lazyClient[*cislogpushjobsapiv1.LogpushJobsApiV1]
 
 	// CIS Range app service option
-	cisRangeAppErr    error
-	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
+	cisRangeAppClient *lazyClient[*cisrangeappv1.RangeApplicationsV1]
 
 	// CIS WAF rule service options
-	cisWAFRuleErr    error
-	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
+	cisWAFRuleClient *lazyClient[*ciswafrulev1.WafRulesApiV1]
 	// IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityAPI *lazyClient[*iamidentity.IamIdentityV1]
 
 	// Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerAPI *lazyClient[*resourcemanager.ResourceManagerV2]
 
 	// Catalog Management Option
-	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
-	catalogManagementClientErr error
+	catalogManagementClient *lazyClient[*catalogmanagementv1.CatalogManagementV1]
 
-	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
-	enterpriseManagementClientErr error
+	enterpriseManagementClient *lazyClient[*enterprisemanagementv1.EnterpriseManagementV1]
 
 	// Resource Controller Option
-	resourceControllerErr   error
-	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
-	secretsManagerClient    *secretsmanagerv2.SecretsManagerV2
-	secretsManagerClientErr error
+	resourceControllerAPI *lazyClient[*resourcecontroller.ResourceControllerV2]
+	secretsManagerClient  *lazyClient[*secretsmanagerv2.SecretsManagerV2]
 
 	// Schematics service options
-	schematicsClient    *schematicsv1.SchematicsV1
-	schematicsClientErr error
+	schematicsClient *lazyClient[*schematicsv1.SchematicsV1]
 
 	// Satellite service
-	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
-	satelliteClientErr error
+	satelliteClient *lazyClient[*kubernetesserviceapiv1.KubernetesServiceApiV1]
 
 	// IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementAPI *lazyClient[*iampolicymanagement.IamPolicyManagementV1]
 
 	// IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsAPI *lazyClient[*iamaccessgroups.IamAccessGroupsV2]
 
 	// MTLS Session options
-	cisMtlsClient *cismtlsv1.MtlsV1
-	cisMtlsErr    error
+	cisMtlsClient *lazyClient[*cismtlsv1.MtlsV1]
 
 	// Bot Management options
-	cisBotManagementClient *cisbotmanagementv1.BotManagementV1
-	cisBotManagementErr    error
+	cisBotManagementClient *lazyClient[*cisbotmanagementv1.BotManagementV1]
 
 	// Bot Analytics options
-	cisBotAnalyticsClient *cisbotanalyticsv1.BotAnalyticsV1
-	cisBotAnalyticsErr    error
+	cisBotAnalyticsClient *lazyClient[*cisbotanalyticsv1.BotAnalyticsV1]
 
 	// CIS Webhooks options
-	cisWebhooksClient *ciswebhooksv1.WebhooksV1
-	cisWebhooksErr    error
+	cisWebhooksClient *lazyClient[*ciswebhooksv1.WebhooksV1]
 
 	// CIS Filters options
-	cisFiltersClient *cisfiltersv1.FiltersV1
-	cisFiltersErr    error
+	cisFiltersClient *lazyClient[*cisfiltersv1.FiltersV1]
 
 	// CIS FirewallRules options
-	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
-	cisFirewallRulesErr    error
+	cisFirewallRulesClient *lazyClient[*cisfirewallrulesv1.FirewallRulesV1]
 
 	// Atracker
-	atrackerClientV2    *atrackerv2.AtrackerV2
-	atrackerClientV2Err error
+	atrackerClientV2 *lazyClient[*atrackerv2.AtrackerV2]
 
 	// Metrics Router
-	metricsRouterClient    *metricsrouterv3.MetricsRouterV3
-	metricsRouterClientErr error
+	metricsRouterClient *lazyClient[*metricsrouterv3.MetricsRouterV3]
 
 	// Satellite link service
-	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
-	satelliteLinkClientErr error
+	satelliteLinkClient *lazyClient[*satellitelinkv1.SatelliteLinkV1]
 
-	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
-	esSchemaRegistryErr    error
+	esSchemaRegistryClient *lazyClient[*schemaregistryv1.SchemaregistryV1]
 
 	// Security and Compliance Center (SCC)
-	securityAndComplianceCenterClient    *scc.SecurityAndComplianceCenterApiV3
-	securityAndComplianceCenterClientErr error
+	securityAndComplianceCenterClient *lazyClient[*scc.SecurityAndComplianceCenterApiV3]
 
 	// context Based Restrictions (CBR)
-	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
-	contextBasedRestrictionsClientErr error
+	contextBasedRestrictionsClient *lazyClient[*contextbasedrestrictionsv1.ContextBasedRestrictionsV1]
 
 	// CD Toolchain
-	cdToolchainClient    *cdtoolchainv2.CdToolchainV2
-	cdToolchainClientErr error
+	cdToolchainClient *lazyClient[*cdtoolchainv2.CdToolchainV2]
 
 	// CD Tekton Pipeline
-	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
-	cdTektonPipelineClientErr error
+	cdTektonPipelineClient *lazyClient[*cdtektonpipelinev2.CdTektonPipelineV2]
 
 	// Code Engine options
-	codeEngineClient    *codeengine.CodeEngineV2
-	codeEngineClientErr error
+	codeEngineClient *lazyClient[*codeengine.CodeEngineV2]
 
 	// Project options
-	projectClient    *project.ProjectV1
-	projectClientErr error
+	projectClient *lazyClient[*project.ProjectV1]
 
 	// Usage Reports options
-	usageReportsClient    *usagereportsv4.UsageReportsV4
-	usageReportsClientErr error
+	usageReportsClient *lazyClient[*usagereportsv4.UsageReportsV4]
 
-	mqcloudClient    *mqcloudv1.MqcloudV1
-	mqcloudClientErr error
+	mqcloudClient *lazyClient[*mqcloudv1.MqcloudV1]
 
 	// VMware as a Service
-	vmwareClient    *vmwarev1.VmwareV1
-	vmwareClientErr error
+	vmwareClient *lazyClient[*vmwarev1.VmwareV1]
 
 	// Cloud Logs
-	logsClient    *logsv0.LogsV0
-	logsClientErr error
+	logsClient *lazyClient[*logsv0.LogsV0]
 }
 
 // Usage Reports
 func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
-	return session.usageReportsClient, session.usageReportsClientErr
+	return session.usageReportsClient.get()
 }
 
 // AppIDAPI provides AppID Service APIs ...
 func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
-	return session.appidAPI, session.appidErr
+	return session.appidAPI.get()
 }
 
 func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
-	return session.catalogManagementClient, session.catalogManagementClientErr
+	return session.catalogManagementClient.get()
 }
 
 // BluemixAcccountAPI ...
 func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
-	return sess.bmxAccountServiceAPI, sess.accountConfigErr
+	return sess.bmxAccountServiceAPI.get()
 }
 
 // BluemixAcccountAPI ...
 func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
-	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
+	return sess.bmxAccountv1ServiceAPI.get()
 }
 
 // BluemixSession to provide the Bluemix Session
@@ -681,118 +627,138 @@ func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// DefaultResourceGroupID returns the resource_group_id configured in the provider
+func (sess clientSession) DefaultResourceGroupID() string {
+	return sess.defaultResourceGroupID
+}
+
+// WarnOnMissingResources reports whether a warning is emitted for the resources found deleted on refresh
+func (sess clientSession) WarnOnMissingResources() bool {
+	return sess.warnOnMissingResources
+}
+
+// ValidateAvailability reports whether the availability of the VPC profiles, images and zones is checked at plan time
+func (sess clientSession) ValidateAvailability() bool {
+	return sess.validateAvailability
+}
+
+// DefaultTags returns the default_tags configured in the provider
+func (sess clientSession) DefaultTags() []string {
+	return sess.defaultTags
+}
+
 // ContainerAPI provides Container Service APIs ...
 func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
-	return sess.csServiceAPI, sess.csConfigErr
+	return sess.csServiceAPI.get()
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
 func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
-	return sess.csv2ServiceAPI, sess.csv2ConfigErr
+	return sess.csv2ServiceAPI.get()
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
 func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
-	return session.containerRegistryClient, session.containerRegistryClientErr
+	return session.containerRegistryClient.get()
 }
 
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
-	if sess.schematicsClientErr != nil {
-		return sess.schematicsClient, sess.schematicsClientErr
+	client, err := sess.schematicsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.schematicsClient.Clone(), nil
-	// return sess.schematicsClient, sess.schematicsClientErr
+	return client.Clone(), nil
 }
 
 // FunctionClient ...
 func (sess clientSession) FunctionClient() (*whisk.Client, error) {
-	return sess.functionClient, sess.functionConfigErr
+	return sess.functionClient.get()
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
 func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
-	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
+	return sess.globalSearchServiceAPI.get()
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
 func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
-	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
+	return sess.globalTaggingServiceAPI.get()
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
 func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
-	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
+	return sess.globalTaggingServiceAPIV1.get()
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
 func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
-	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
+	return sess.globalSearchServiceAPIV2.get()
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
 func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
-	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
+	return sess.hpcsEndpointAPI.get()
 }
 
 // UKO
 func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
-	return session.ukoClient, session.ukoClientErr
+	return session.ukoClient.get()
 }
 
 // UserManagementAPI provides User management APIs ...
 func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
-	return sess.userManagementAPI, sess.userManagementErr
+	return sess.userManagementAPI.get()
 }
 
 // IAM Policy Management
 func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
-	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
+	return sess.iamPolicyManagementAPI.get()
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
 func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
-	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
+	return sess.iamAccessGroupsAPI.get()
 }
 
 // IBM Cloud Shell
 func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
-	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
+	return session.ibmCloudShellClient.get()
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
 func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
-	return sess.icdServiceAPI, sess.icdConfigErr
+	return sess.icdServiceAPI.get()
 }
 
 // The IBM Cloud Databases API
 func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
-	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
+	return session.cloudDatabasesClient.get()
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
 func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
-	return sess.cfServiceAPI, sess.cfConfigErr
+	return sess.cfServiceAPI.get()
 }
 
 // ResourceCatalogAPI ...
 func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
-	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
+	return sess.resourceCatalogServiceAPI.get()
 }
 
 // ResourceManagementAPIv2 ...
 func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
-	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
+	return sess.resourceManagementServiceAPIv2.get()
 }
 
 // ResourceControllerAPI ...
 func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
-	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
+	return sess.resourceControllerServiceAPI.get()
 }
 
 // ResourceControllerAPIv2 ...
 func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
-	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
+	return sess.resourceControllerServiceAPIv2.get()
 }
 
 // SoftLayerSession providers SoftLayer Session
@@ -802,546 +768,506 @@ func (sess clientSession) SoftLayerSession() *slsession.Session {
 
 // apigatewayAPI provides API Gateway APIs
 func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
-	return sess.apigatewayAPI, sess.apigatewayErr
+	return sess.apigatewayAPI.get()
 }
 
 func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
-	return session.pushServiceClient, session.pushServiceClientErr
+	return session.pushServiceClient.get()
 }
 
 func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
-	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
+	return session.eventNotificationsApiClient.get()
 }
 
 func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
-	return session.appConfigurationClient, session.appConfigurationClientErr
+	return session.appConfigurationClient.get()
 }
 
 func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
-	return sess.kpAPI, sess.kpErr
+	return sess.kpAPI.get()
 }
 
 func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
-	if sess.kmsErr == nil {
+	kmsAPI, err := sess.kmsAPI.get()
+	if err == nil {
 		var clientConfig *kp.ClientConfig
-		if sess.kmsAPI.Config.APIKey != "" {
+		if kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
-				APIKey:   sess.kmsAPI.Config.APIKey, // pragma: allowlist secret
+				BaseURL:  sess.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsAPI.Config.BaseURL),
+				APIKey:   kmsAPI.Config.APIKey, // pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
-				TokenURL: sess.kmsAPI.Config.TokenURL,
+				TokenURL: kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsAPI.Config.BaseURL),
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, // pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
-				TokenURL:      sess.kmsAPI.Config.TokenURL,
+				TokenURL:      kmsAPI.Config.TokenURL,
 			}
 		}
 
 		kpClient, err := kp.New(*clientConfig, DefaultTransport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			err = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
-		return kpClient, nil
+		return kpClient, err
 	}
-	return sess.kmsAPI, sess.kmsErr
+	return kmsAPI, err
 }
 
 func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
-	return sess.vpcAPI, sess.vpcErr
+	return sess.vpcAPI.get()
 }
 
 func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
-	return sess.vpcBetaAPI, sess.vpcbetaErr
+	return sess.vpcBetaAPI.get()
 }
 
 func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
-	return sess.directlinkAPI, sess.directlinkErr
+	return sess.directlinkAPI.get()
 }
 
 func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
-	return sess.dlProviderAPI, sess.dlProviderErr
+	return sess.dlProviderAPI.get()
 }
 
 func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
-	return sess.cosConfigAPI, sess.cosConfigErr
+	return sess.cosConfigAPI.get()
 }
 
 func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
-	return sess.transitgatewayAPI, sess.transitgatewayErr
+	return sess.transitgatewayAPI.get()
 }
 
 // Session to the Power Colo Service
 
 func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
-	return sess.ibmpiSession, sess.ibmpiConfigErr
+	return sess.ibmpiSession.get()
 }
 
 // Private DNS Service
 
 func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
-	return sess.pDNSClient, sess.pDNSErr
+	return sess.pDNSClient.get()
 }
 
 // Session to the Namespace cloud function
 
 func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
-	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
+	return sess.functionIAMNamespaceAPI.get()
 }
 
 // CIS Zones Service
 func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
-	if sess.cisZonesErr != nil {
-		return sess.cisZonesV1Client, sess.cisZonesErr
+	client, err := sess.cisZonesV1Client.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisZonesV1Client.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS DNS Service
 func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
-	if sess.cisDNSErr != nil {
-		return sess.cisDNSRecordsClient, sess.cisDNSErr
+	client, err := sess.cisDNSRecordsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisDNSRecordsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS DNS Bulk Service
 func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
-	if sess.cisDNSBulkErr != nil {
-		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
+	client, err := sess.cisDNSRecordBulkClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisDNSRecordBulkClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS GLB Pool
 func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
-	if sess.cisGLBPoolErr != nil {
-		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
+	client, err := sess.cisGLBPoolClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisGLBPoolClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS GLB
 func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
-	if sess.cisGLBErr != nil {
-		return sess.cisGLBClient, sess.cisGLBErr
+	client, err := sess.cisGLBClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisGLBClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS GLB Health Check/Monitor
 func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
-	if sess.cisGLBHealthCheckErr != nil {
-		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
+	client, err := sess.cisGLBHealthCheckClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisGLBHealthCheckClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Zone Rate Limits
 func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
-	if sess.cisRLErr != nil {
-		return sess.cisRLClient, sess.cisRLErr
+	client, err := sess.cisRLClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisRLClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS IP
 func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
-	if sess.cisIPErr != nil {
-		return sess.cisIPClient, sess.cisIPErr
+	client, err := sess.cisIPClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisIPClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Page Rules
 func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
-	if sess.cisPageRuleErr != nil {
-		return sess.cisPageRuleClient, sess.cisPageRuleErr
+	client, err := sess.cisPageRuleClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisPageRuleClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Edge Function
 func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
-	if sess.cisEdgeFunctionErr != nil {
-		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
+	client, err := sess.cisEdgeFunctionClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisEdgeFunctionClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS SSL certificate
 func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
-	if sess.cisSSLErr != nil {
-		return sess.cisSSLClient, sess.cisSSLErr
+	client, err := sess.cisSSLClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisSSLClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS WAF Packages
 func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
-	if sess.cisWAFPackageErr != nil {
-		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
+	client, err := sess.cisWAFPackageClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisWAFPackageClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Zone Settings
 func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
-	if sess.cisDomainSettingsErr != nil {
-		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
+	client, err := sess.cisDomainSettingsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisDomainSettingsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Alerts
 func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
-	if sess.cisAlertsErr != nil {
-		return sess.cisAlertsClient, sess.cisAlertsErr
+	client, err := sess.cisAlertsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisAlertsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Rulesets
 func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
-	if sess.cisRulesetsErr != nil {
-		return sess.cisRulesetsClient, sess.cisRulesetsErr
+	client, err := sess.cisRulesetsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisRulesetsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Routing
 func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
-	if sess.cisRoutingErr != nil {
-		return sess.cisRoutingClient, sess.cisRoutingErr
+	client, err := sess.cisRoutingClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisRoutingClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS WAF Group
 func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
-	if sess.cisWAFGroupErr != nil {
-		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
+	client, err := sess.cisWAFGroupClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisWAFGroupClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Cache service
 func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
-	if sess.cisCacheErr != nil {
-		return sess.cisCacheClient, sess.cisCacheErr
+	client, err := sess.cisCacheClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisCacheClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Zone Settings
 func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
-	if sess.cisCustomPageErr != nil {
-		return sess.cisCustomPageClient, sess.cisCustomPageErr
+	client, err := sess.cisCustomPageClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisCustomPageClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Firewall access rule
 func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
-	if sess.cisAccessRuleErr != nil {
-		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
+	client, err := sess.cisAccessRuleClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisAccessRuleClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS User Agent Blocking rule
 func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
-	if sess.cisUARuleErr != nil {
-		return sess.cisUARuleClient, sess.cisUARuleErr
+	client, err := sess.cisUARuleClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisUARuleClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Firewall Lockdown rule
 func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
-	if sess.cisLockdownErr != nil {
-		return sess.cisLockdownClient, sess.cisLockdownErr
+	client, err := sess.cisLockdownClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisLockdownClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Range app rule
 func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
-	if sess.cisRangeAppErr != nil {
-		return sess.cisRangeAppClient, sess.cisRangeAppErr
+	client, err := sess.cisRangeAppClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisRangeAppClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS WAF Rule
 func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
-	if sess.cisWAFRuleErr != nil {
-		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
+	client, err := sess.cisWAFRuleClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisWAFRuleClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Authenticated Origin Pull
 func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
-	if sess.cisOriginAuthPullErr != nil {
-		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
+	client, err := sess.cisOriginAuthClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisOriginAuthClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // IAM Identity Session
 func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
-	return sess.iamIdentityAPI, sess.iamIdentityErr
+	return sess.iamIdentityAPI.get()
 }
 
 // ResourceMAanger Session
 func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
-	return sess.resourceManagerAPI, sess.resourceManagerErr
+	return sess.resourceManagerAPI.get()
 }
 
 func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
-	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
+	return session.enterpriseManagementClient.get()
 }
 
 // ResourceController Session
 func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
-	return sess.resourceControllerAPI, sess.resourceControllerErr
+	return sess.resourceControllerAPI.get()
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
 func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
-	return session.secretsManagerClient, session.secretsManagerClientErr
+	return session.secretsManagerClient.get()
 }
 
 // Satellite Link
 func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
-	return session.satelliteLinkClient, session.satelliteLinkClientErr
+	return session.satelliteLinkClient.get()
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
 func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
-	return sess.satelliteClient, sess.satelliteClientErr
+	return sess.satelliteClient.get()
 }
 
 // CIS LogPushJob
 func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
-	if sess.cisLogpushJobsErr != nil {
-		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
+	client, err := sess.cisLogpushJobsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisLogpushJobsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS MTLS session
 func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
-	if sess.cisMtlsErr != nil {
-		return sess.cisMtlsClient, sess.cisMtlsErr
+	client, err := sess.cisMtlsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisMtlsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Bot Management
 func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
-	if sess.cisBotManagementErr != nil {
-		return sess.cisBotManagementClient, sess.cisBotManagementErr
+	client, err := sess.cisBotManagementClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisBotManagementClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Bot Analytics
 func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
-	if sess.cisBotAnalyticsErr != nil {
-		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
+	client, err := sess.cisBotAnalyticsClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisBotAnalyticsClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Webhooks
 func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
-	if sess.cisWebhooksErr != nil {
-		return sess.cisWebhooksClient, sess.cisWebhooksErr
+	client, err := sess.cisWebhooksClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisWebhooksClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS Filters
 func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
-	if sess.cisFiltersErr != nil {
-		return sess.cisFiltersClient, sess.cisFiltersErr
+	client, err := sess.cisFiltersClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisFiltersClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // CIS FirewallRules
 func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
-	if sess.cisFirewallRulesErr != nil {
-		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
+	client, err := sess.cisFirewallRulesClient.get()
+	if err != nil {
+		return client, err
 	}
-	return sess.cisFirewallRulesClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // Activity Tracker API
 func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
-	return session.atrackerClientV2, session.atrackerClientV2Err
+	return session.atrackerClientV2.get()
 }
 
 // Metrics Router API Version 3
 func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
-	return session.metricsRouterClient, session.metricsRouterClientErr
+	return session.metricsRouterClient.get()
 }
 
 func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
-	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
+	return session.esSchemaRegistryClient.get()
 }
 
 // Security and Compliance center Admin API
 func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
-	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
+	return session.securityAndComplianceCenterClient.get()
 }
 
 // Context Based Restrictions
 func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
-	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
+	return session.contextBasedRestrictionsClient.get()
 }
 
 // CD Toolchain
 func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
-	return session.cdToolchainClient, session.cdToolchainClientErr
+	return session.cdToolchainClient.get()
 }
 
 // CD Tekton Pipeline
 func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
-	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
+	return session.cdTektonPipelineClient.get()
 }
 
 // Code Engine
 func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
-	return session.codeEngineClient, session.codeEngineClientErr
+	return session.codeEngineClient.get()
 }
 
 // Projects API Specification
 func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
-	return session.projectClient, session.projectClientErr
+	return session.projectClient.get()
 }
 
 // MQ on Cloud
 func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
-	if session.mqcloudClientErr != nil {
-		sessionMqcloudClient := session.mqcloudClient
-		sessionMqcloudClient.EnableRetries(0, 0)
-		return session.mqcloudClient, session.mqcloudClientErr
+	client, err := session.mqcloudClient.get()
+	if err != nil {
+		return client, err
 	}
-	return session.mqcloudClient.Clone(), nil
+	return client.Clone(), nil
 }
 
 // VMware as a Service API
 func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
-	return session.vmwareClient, session.vmwareClientErr
+	return session.vmwareClient.get()
 }
 
 // Cloud Logs
 func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
-	return session.logsClient, session.logsClientErr
+	return session.logsClient.get()
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
+	c.detectVisibility()
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:                sess,
+		defaultResourceGroupID: c.DefaultResourceGroupID,
+		warnOnMissingResources: c.WarnOnMissingResources,
+		validateAvailability:   c.ValidateAvailability,
+		defaultTags:            c.DefaultTags,
+		endpoints:              c.Endpoints,
 	}
 
 	if sess.BluemixSession == nil {
 		// Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
 		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.containerRegistryClientErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.pushServiceClientErr = errEmptyBluemixCredentials
-		session.appConfigurationClientErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
 		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamAccessGroupsErr = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagerErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
-		session.resourceControllerErr = errEmptyBluemixCredentials
-		session.catalogManagementClientErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.vpcbetaErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
 		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisAlertsErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisMtlsErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-		session.secretsManagerClientErr = errEmptyBluemixCredentials
-		session.cisFiltersErr = errEmptyBluemixCredentials
-		session.cisWebhooksErr = errEmptyBluemixCredentials
-		session.cisLogpushJobsErr = errEmptyBluemixCredentials
-		session.schematicsClientErr = errEmptyBluemixCredentials
-		session.satelliteClientErr = errEmptyBluemixCredentials
-		session.iamPolicyManagementErr = errEmptyBluemixCredentials
-		session.satelliteLinkClientErr = errEmptyBluemixCredentials
-		session.esSchemaRegistryErr = errEmptyBluemixCredentials
-		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
-		session.securityAndComplianceCenterClientErr = errEmptyBluemixCredentials
-		session.cdTektonPipelineClientErr = errEmptyBluemixCredentials
-		session.cdToolchainClientErr = errEmptyBluemixCredentials
-		session.codeEngineClientErr = errEmptyBluemixCredentials
-		session.projectClientErr = errEmptyBluemixCredentials
-		session.mqcloudClientErr = errEmptyBluemixCredentials
-		session.logsClientErr = errEmptyBluemixCredentials
 
 		return session, nil
 	}
@@ -1359,11 +1285,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 			}
 			if err != nil {
 				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 			}
 		}
-
-		session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 	}
 
 	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
@@ -1395,7 +1318,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	session.functionClient = newLazyClient(func() (*whisk.Client, error) {
+		return FunctionClient(sess.BluemixSession.Config)
+	})
 
 	BluemixRegion = sess.BluemixSession.Config.Region
 	var fileMap map[string]interface{}
@@ -1414,41 +1339,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
+	session.bmxAccountv1ServiceAPI = newLazyClient(func() (accountv1.AccountServiceAPI, error) {
+		accv1API, err := accountv1.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
+		}
+		return accv1API, err
+	})
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
+	session.bmxAccountServiceAPI = newLazyClient(func() (accountv2.AccountServiceAPI, error) {
+		accAPI, err := accountv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
+		}
+		return accAPI, err
+	})
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
+	session.cfServiceAPI = newLazyClient(func() (mccpv2.MccpServiceAPI, error) {
+		cfAPI, err := mccpv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
+		}
+		return cfAPI, err
+	})
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
+	session.csServiceAPI = newLazyClient(func() (containerv1.ContainerServiceAPI, error) {
+		clusterAPI, err := containerv1.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+		return clusterAPI, err
+	})
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
+	session.csv2ServiceAPI = newLazyClient(func() (containerv2.ContainerServiceAPI, error) {
+		v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+		return v2clusterAPI, err
+	})
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
+	session.hpcsEndpointAPI = newLazyClient(func() (hpcs.HPCSV2, error) {
+		hpcsAPI, err := hpcs.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
+		}
+		return hpcsAPI, err
+	})
 
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1457,39 +1394,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 	}
-	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	session.kpAPI = newLazyClient(func() (*kp.API, error) {
+		var options kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			options = kp.ClientConfig{
+				BaseURL: c.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
+		} else {
+			options = kp.ClientConfig{
+				BaseURL:       c.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 		}
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+		kpAPIclient, err := kp.New(options, DefaultTransport())
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
+		return kpAPIclient, err
+	})
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+	iamURL := c.iamEndpoint()
 
 	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
@@ -1499,46 +1428,49 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 	}
-	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+	session.kmsAPI = newLazyClient(func() (*kp.API, error) {
+		var kmsOptions kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			kmsOptions = kp.ClientConfig{
+				BaseURL: c.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
+		} else {
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       c.endpointFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
 		}
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 		}
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
+		return kmsAPIclient, err
+	})
 
 	var authenticator core.Authenticator
 
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+	if c.usesCRToken() {
+		authenticator = c.containerAuthenticator()
+	} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
 		if c.BluemixAPIKey != "" {
 			authenticator = &core.IamAuthenticator{
 				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				URL:    c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
 			}
 		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
+			// Use the IAM access token until it expires, then refresh it with the IAM refresh token.
+			authenticator = newTokenAuthenticator(
+				sess.BluemixSession.Config.IAMAccessToken,
+				sess.BluemixSession.Config.IAMRefreshToken,
+				c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			)
 		}
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
@@ -1555,27 +1487,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
 	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
-	}
-	// Construct an "options" struct for creating the service client.
-	projectClientOptions := &project.ProjectV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.projectClient, err = project.NewProjectV1(projectClientOptions)
-	if err == nil {
+	session.projectClient = newLazyClient(func() (*project.ProjectV1, error) {
+		// Construct an "options" struct for creating the service client.
+		projectClientOptions := &project.ProjectV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		projectClient, err := project.NewProjectV1(projectClientOptions)
+		if err != nil {
+			return projectClient, fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
+		}
 		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(projectClient.Service)
 		// Add custom header for analytics
-		session.projectClient.SetDefaultHeaders(gohttp.Header{
+		projectClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
-	}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			return projectClient, fmt.Errorf("Project Service API does not support private endpoints")
+		}
+		return projectClient, nil
+	})
 
 	// Construct an "options" struct for creating the service client.
 	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
@@ -1587,65 +1520,68 @@ func (c *Config) ClientSession() (interface{}, error) {
 		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
 	}
 
-	logsClientOptions := &logsv0.LogsV0Options{
-		Authenticator: authenticator,
-		URL:           logsEndpoint,
-	}
-
-	// Construct the service client.
-	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
-	if err == nil {
+	session.logsClient = newLazyClient(func() (*logsv0.LogsV0, error) {
+		logsClientOptions := &logsv0.LogsV0Options{
+			Authenticator: authenticator,
+			URL:           logsEndpoint,
+		}
+		// Construct the service client.
+		logsClient, err := logsv0.NewLogsV0(logsClientOptions)
+		if err != nil {
+			return logsClient, fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(logsClient.Service)
 		// Add custom header for analytics
-		session.logsClient.SetDefaultHeaders(gohttp.Header{
+		logsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
-	}
-
-	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
+		return logsClient, nil
+	})
 
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
+	session.ukoClient = newLazyClient(func() (*ukov4.UkoV4, error) {
+		// Construct an "options" struct for creating the service client.
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		ukoClient, err := ukov4.NewUkoV4(ukoClientOptions)
+		if err != nil {
+			return ukoClient, fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(ukoClient.Service)
 		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
+		ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
-	}
+		return ukoClient, nil
+	})
 
 	// APP ID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
-	if c.Visibility == "private" {
-		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
-	}
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
 	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
-	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.appidAPI = appIDClient
+	session.appidAPI = newLazyClient(func() (*appid.AppIDManagementV4, error) {
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			err = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		} else if c.Visibility == "private" {
+			err = fmt.Errorf("App Id resources doesnot support private endpoints")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			c.enableRetries(appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return appIDClient, err
+	})
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
@@ -1659,23 +1595,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
 	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
-
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
+	session.contextBasedRestrictionsClient = newLazyClient(func() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		}
+		// Construct the service client.
+		contextBasedRestrictionsClient, err := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err != nil {
+			return contextBasedRestrictionsClient, fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+		contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
-	}
+		return contextBasedRestrictionsClient, nil
+	})
 
 	// // Usage Reports Service Client
 	usageReportsURL := usagereportsv4.DefaultServiceURL
@@ -1698,47 +1635,51 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
 	}
-	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
-	}
-	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
-	if err != nil {
-		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
-	}
-	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		usageReportsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.usageReportsClient = usageReportsClient
+	session.usageReportsClient = newLazyClient(func() (*usagereportsv4.UsageReportsV4, error) {
+		usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+		}
+		usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+		}
+		if usageReportsClient != nil && usageReportsClient.Service != nil {
+			c.enableRetries(usageReportsClient.Service)
+			usageReportsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return usageReportsClient, err
+	})
 
 	// CATALOG MANAGEMENT Service
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	if c.Visibility == "private" {
-		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
-	}
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
 	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.catalogManagementClient = newLazyClient(func() (*catalogmanagementv1.CatalogManagementV1, error) {
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		catalogManagementClient, err := catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		} else if c.Visibility == "private" {
+			err = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
+		}
+		if catalogManagementClient != nil && catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			c.enableRetries(catalogManagementClient.Service)
+			// Add custom header for analytics
+			catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return catalogManagementClient, err
+	})
 
 	// ATRACKER Version 2
 	var atrackerClientV2URL string
@@ -1758,26 +1699,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
 	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
-		session.atrackerClientV2Err = atrackerURLV2Err
-	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
+	session.atrackerClientV2 = newLazyClient(func() (*atrackerv2.AtrackerV2, error) {
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		}
+		atrackerClientV2, err := atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err != nil {
+			return atrackerClientV2, fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(atrackerClientV2.Service)
 		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+		atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
-	}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
+		// This should technically never happen as we default this for v2
+		if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
+			return atrackerClientV2, atrackerURLV2Err
+		}
+		return atrackerClientV2, nil
+	})
 
 	// Construct an "options" struct for creating the service client for Metrics Router
 	var metricsRouterClientURL string
@@ -1797,23 +1740,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
 	}
-	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
-	}
-
-	// Construct the service client.
-	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
-	if err == nil {
+	session.metricsRouterClient = newLazyClient(func() (*metricsrouterv3.MetricsRouterV3, error) {
+		metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
+		}
+		// Construct the service client.
+		metricsRouterClient, err := metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
+		if err != nil {
+			return metricsRouterClient, fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
+		}
 		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(metricsRouterClient.Service)
 		// Add custom header for analytics
-		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
+		metricsRouterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
-	}
+		return metricsRouterClient, nil
+	})
 
 	// SCC (Security and Compliance Center) Service
 	sccApiClientURL := scc.DefaultServiceURL
@@ -1821,23 +1765,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
 		sccApiClientURL = regionURL
 	}
-	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
-	}
-
-	// Construct the service client.
-	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
-	if err == nil {
+	session.securityAndComplianceCenterClient = newLazyClient(func() (*scc.SecurityAndComplianceCenterApiV3, error) {
+		sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
+		}
+		// Construct the service client.
+		securityAndComplianceCenterClient, err := scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
+		if err != nil {
+			return securityAndComplianceCenterClient, fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
+		}
 		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(securityAndComplianceCenterClient.Service)
 		// Add custom header for analytics
-		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
+		securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
-	}
+		return securityAndComplianceCenterClient, nil
+	})
 
 	// SCHEMATICS Service
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
@@ -1848,23 +1793,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
 	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
-	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.schematicsClient = schematicsClient
+	session.schematicsClient = newLazyClient(func() (*schematicsv1.SchematicsV1, error) {
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
+		// Enable retries for API calls
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			c.enableRetries(schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return schematicsClient, err
+	})
 
 	// VPC Service
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -1874,62 +1821,67 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
 	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcAPI = vpcclient
-
-	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
-	if err != nil {
-		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
-	}
-	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcBetaAPI = vpcbetaclient
+	session.vpcAPI = newLazyClient(func() (*vpc.VpcV1, error) {
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			c.enableRetries(vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return vpcclient, err
+	})
 
-	// PUSH NOTIFICATIONS Service
+	session.vpcBetaAPI = newLazyClient(func() (*vpcbeta.VpcbetaV1, error) {
+		vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
+		}
+		if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+			c.enableRetries(vpcbetaclient.Service)
+			vpcbetaclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return vpcbetaclient, err
+	})
+
+	// PUSH NOTIFICATIONS Service
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
-	if c.Visibility == "private" {
-		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
-	}
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
 	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
-	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.pushServiceClient = pnclient
+	session.pushServiceClient = newLazyClient(func() (*pushservicev1.PushServiceV1, error) {
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		} else if c.Visibility == "private" {
+			err = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			c.enableRetries(pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return pnclient, err
+	})
 
 	// event notifications
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
@@ -1941,23 +1893,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
 	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
-	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.eventNotificationsApiClient = newLazyClient(func() (*eventnotificationsv1.EventNotificationsV1, error) {
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		}
+		// Construct the service client.
+		eventNotificationsApiClient, err := eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if eventNotificationsApiClient != nil && eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			c.enableRetries(eventNotificationsApiClient.Service)
+			eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return eventNotificationsApiClient, err
+	})
 
 	// APP CONFIGURATION Service
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
@@ -1967,19 +1921,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
 	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
+	session.appConfigurationClient = newLazyClient(func() (*appconfigurationv1.AppConfigurationV1, error) {
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
+			Authenticator: authenticator,
+		}
 
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient == nil {
+			return nil, fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		}
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
-	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
-	}
+		c.enableRetries(appConfigClient.Service)
+		return appConfigClient, nil
+	})
 
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
@@ -1996,51 +1951,60 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
 	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
-		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.containerRegistryClient = newLazyClient(func() (*containerregistryv1.ContainerRegistryV1, error) {
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+			Account:       core.StringPtr(userConfig.UserAccount),
+		}
+		// Construct the service client.
+		containerRegistryClient, err := containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if containerRegistryClient != nil && containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			c.enableRetries(containerRegistryClient.Service)
+			// Add custom header for analytics
+			containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return containerRegistryClient, err
+	})
 
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
 	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
+	session.cosConfigAPI = newLazyClient(func() (*cosconfig.ResourceConfigurationV1, error) {
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+		}
+		return cosconfigclient, err
+	})
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
+	session.globalSearchServiceAPI = newLazyClient(func() (globalsearchv2.GlobalSearchServiceAPI, error) {
+		globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		return globalSearchAPI, err
+	})
 	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
+	session.globalTaggingServiceAPI = newLazyClient(func() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+		globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		return globalTaggingAPI, err
+	})
 
 	// GLOBAL TAGGING Service
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
@@ -2056,21 +2020,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
 	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.globalTaggingServiceAPIV1 = newLazyClient(func() (globaltaggingv1.GlobalTaggingV1, error) {
+		var globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			c.enableRetries(globalTaggingServiceAPIV1.Service)
+			globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return globalTaggingServiceAPIV1, err
+	})
 	// GLOBAL TAGGING Service
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2085,27 +2053,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
 	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
-	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.globalSearchServiceAPIV2 = newLazyClient(func() (searchv2.GlobalSearchV2, error) {
+		var globalSearchServiceAPIV2 searchv2.GlobalSearchV2
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			globalSearchServiceAPIV2 = *globalSearchAPIV2
+			c.enableRetries(globalSearchServiceAPIV2.Service)
+			globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return globalSearchServiceAPIV2, err
+	})
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
+	session.icdServiceAPI = newLazyClient(func() (icdv4.ICDServiceAPI, error) {
+		icdAPI, err := icdv4.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+		return icdAPI, err
+	})
 
 	var cloudDatabasesEndpoint string
 
@@ -2116,59 +2090,72 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
+	session.cloudDatabasesClient = newLazyClient(func() (*clouddatabasesv5.CloudDatabasesV5, error) {
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		cloudDatabasesClient, err := clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err != nil {
+			return cloudDatabasesClient, fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(cloudDatabasesClient.Service)
 		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+		cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
-	}
+		return cloudDatabasesClient, nil
+	})
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
+	session.resourceCatalogServiceAPI = newLazyClient(func() (catalog.ResourceCatalogAPI, error) {
+		resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
+		}
+		return resourceCatalogAPI, err
+	})
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	session.resourceManagementServiceAPIv2 = newLazyClient(func() (managementv2.ResourceManagementAPIv2, error) {
+		resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
+		}
+		return resourceManagementAPIv2, err
+	})
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
+	session.resourceControllerServiceAPI = newLazyClient(func() (controller.ResourceControllerAPI, error) {
+		resourceControllerAPI, err := controller.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		return resourceControllerAPI, err
+	})
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	session.resourceControllerServiceAPIv2 = newLazyClient(func() (controllerv2.ResourceControllerAPIV2, error) {
+		ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+		return ResourceControllerAPIv2, err
+	})
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
+	session.userManagementAPI = newLazyClient(func() (usermanagementv2.UserManagementAPI, error) {
+		userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
+		}
+		return userManagementAPI, err
+	})
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
+	session.functionIAMNamespaceAPI = newLazyClient(func() (functions.FunctionServiceAPI, error) {
+		namespaceFunction, err := functions.New(sess.BluemixSession)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+		return namespaceFunction, err
+	})
 
 	//  API GATEWAY service
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
@@ -2178,31 +2165,35 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
 	}
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
-	if err != nil {
-		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
-	}
-	session.apigatewayAPI = apigatewayAPI
+	session.apigatewayAPI = newLazyClient(func() (*apigateway.ApiGatewayControllerApiV1, error) {
+		APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+			Authenticator: &core.NoAuthAuthenticator{},
+		}
+		apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+		}
+		return apigatewayAPI, err
+	})
 
 	// POWER SYSTEMS Service
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
-	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
-		Debug:         os.Getenv("TF_LOG") != "",
-		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
-		Zone:          c.Zone,
-	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
-	}
-	session.ibmpiSession = ibmpisession
+	session.ibmpiSession = newLazyClient(func() (*ibmpisession.IBMPISession, error) {
+		ibmPIOptions := &ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			Region:        c.Region,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+			UserAccount:   userConfig.UserAccount,
+			Zone:          c.Zone,
+		}
+		piSession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err != nil {
+			err = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		return piSession, err
+	})
 
 	// PRIVATE DNS Service
 	pdnsURL := dns.DefaultServiceURL
@@ -2212,20 +2203,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
 	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.pDNSClient = newLazyClient(func() (*dns.DnsSvcsV1, error) {
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+			Authenticator: authenticator,
+		}
+		pDNSClient, err := dns.NewDnsSvcsV1(dnsOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", err)
+		}
+		if pDNSClient != nil && pDNSClient.Service != nil {
+			c.enableRetries(pDNSClient.Service)
+			pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return pDNSClient, err
+	})
 
 	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
@@ -2236,21 +2230,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
 	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.directlinkAPI = newLazyClient(func() (*dl.DirectLinkV1, error) {
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		directlinkAPI, err := dl.NewDirectLinkV1(directlinkOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", err)
+		}
+		if directlinkAPI != nil && directlinkAPI.Service != nil {
+			c.enableRetries(directlinkAPI.Service)
+			directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return directlinkAPI, err
+	})
 
 	// DIRECT LINK PROVIDER Service
 	dlproviderURL := dlProviderV2.DefaultServiceURL
@@ -2260,21 +2257,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
 	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.dlProviderAPI = newLazyClient(func() (*dlProviderV2.DirectLinkProviderV2, error) {
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		dlProviderAPI, err := dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", err)
+		}
+		if dlProviderAPI != nil && dlProviderAPI.Service != nil {
+			c.enableRetries(dlProviderAPI.Service)
+			dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return dlProviderAPI, err
+	})
 
 	// TRANSIT GATEWAY Service
 	tgURL := tg.DefaultServiceURL
@@ -2284,659 +2284,727 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
 	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
-	}
+	session.transitgatewayAPI = newLazyClient(func() (*tg.TransitGatewayApisV1, error) {
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		transitgatewayAPI, err := tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", err)
+		}
+		if transitgatewayAPI != nil && transitgatewayAPI.Service != nil {
+			c.enableRetries(transitgatewayAPI.Service)
+			// transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
+		return transitgatewayAPI, err
+	})
 
 	// CIS Service instances starts here.
 	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
 	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	cisEndPoint := c.endpointFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 
 	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisZonesV1Client = newLazyClient(func() (*ciszonesv1.ZonesV1, error) {
+		cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisZonesV1Client, err := ciszonesv1.NewZonesV1(cisZonesV1Opt)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while configuring CIS Zones service: %s",
+				err)
+		}
+		if cisZonesV1Client != nil && cisZonesV1Client.Service != nil {
+			c.enableRetries(cisZonesV1Client.Service)
+			cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisZonesV1Client, err
+	})
 
 	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisDNSRecordsClient = newLazyClient(func() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+		cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisDNSRecordsClient, err := cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", err)
+		}
+		if cisDNSRecordsClient != nil && cisDNSRecordsClient.Service != nil {
+			c.enableRetries(cisDNSRecordsClient.Service)
+			cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisDNSRecordsClient, err
+	})
 
 	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisDNSRecordBulkClient = newLazyClient(func() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+		cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisDNSRecordBulkClient, err := cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while configuration CIS DNS bulk service : %s",
+				err)
+		}
+		if cisDNSRecordBulkClient != nil && cisDNSRecordBulkClient.Service != nil {
+			c.enableRetries(cisDNSRecordBulkClient.Service)
+			cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisDNSRecordBulkClient, err
+	})
 
 	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
-			session.cisGLBPoolErr)
-	}
-	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisGLBPoolClient = newLazyClient(func() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+		cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisGLBPoolClient, err := cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
+				err)
+		}
+		if cisGLBPoolClient != nil && cisGLBPoolClient.Service != nil {
+			c.enableRetries(cisGLBPoolClient.Service)
+			cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisGLBPoolClient, err
+	})
 
 	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
-			session.cisGLBErr)
-	}
-	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisGLBClient = newLazyClient(func() (*cisglbv1.GlobalLoadBalancerV1, error) {
+		cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+		cisGLBClient, err := cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
+				err)
+		}
+		if cisGLBClient != nil && cisGLBClient.Service != nil {
+			c.enableRetries(cisGLBClient.Service)
+			cisGLBClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisGLBClient, err
+	})
 
 	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
-			session.cisGLBHealthCheckErr)
-	}
-	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisGLBHealthCheckClient = newLazyClient(func() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+		cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisGLBHealthCheckClient, err := cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
+				err)
+		}
+		if cisGLBHealthCheckClient != nil && cisGLBHealthCheckClient.Service != nil {
+			c.enableRetries(cisGLBHealthCheckClient.Service)
+			cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisGLBHealthCheckClient, err
+	})
 
 	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisIPClient = newLazyClient(func() (*cisipv1.CisIpApiV1, error) {
+		cisIPOpt := &cisipv1.CisIpApiV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		cisIPClient, err := cisipv1.NewCisIpApiV1(cisIPOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
+				err)
+		}
+		if cisIPClient != nil && cisIPClient.Service != nil {
+			c.enableRetries(cisIPClient.Service)
+			cisIPClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisIPClient, err
+	})
 
 	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisRLClient = newLazyClient(func() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+		cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisRLClient, err := cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while cofiguring CIS Zone Rate Limit service: %s",
+				err)
+		}
+		if cisRLClient != nil && cisRLClient.Service != nil {
+			c.enableRetries(cisRLClient.Service)
+			cisRLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisRLClient, err
+	})
 	// IBM Network CIS Alerts
-	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
-	if session.cisAlertsErr != nil {
-		session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
-			session.cisAlertsErr)
-	}
-	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisAlertsClient = newLazyClient(func() (*cisalertsv1.AlertsV1, error) {
+		cisAlertsOpt := &cisalertsv1.AlertsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisAlertsClient, err := cisalertsv1.NewAlertsV1(cisAlertsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
+				err)
+		}
+		if cisAlertsClient != nil && cisAlertsClient.Service != nil {
+			c.enableRetries(cisAlertsClient.Service)
+			cisAlertsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisAlertsClient, err
+	})
 
 	// IBM Network CIS Rulesets
-	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
-	if session.cisRulesetsErr != nil {
-		session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
-			session.cisRulesetsErr)
-	}
-	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
-		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisRulesetsClient = newLazyClient(func() (*cisrulesetsv1.RulesetsV1, error) {
+		cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisRulesetsClient, err := cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
+				err)
+		}
+		if cisRulesetsClient != nil && cisRulesetsClient.Service != nil {
+			c.enableRetries(cisRulesetsClient.Service)
+			cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisRulesetsClient, err
+	})
 
 	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisPageRuleClient = newLazyClient(func() (*cispagerulev1.PageRuleApiV1, error) {
+		cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisPageRuleClient, err := cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while cofiguring CIS Page Rule service: %s",
+				err)
+		}
+		if cisPageRuleClient != nil && cisPageRuleClient.Service != nil {
+			c.enableRetries(cisPageRuleClient.Service)
+			cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisPageRuleClient, err
+	})
 
 	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
-			session.cisEdgeFunctionErr)
-	}
-	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisEdgeFunctionClient = newLazyClient(func() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+		cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisEdgeFunctionClient, err := cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
+				err)
+		}
+		if cisEdgeFunctionClient != nil && cisEdgeFunctionClient.Service != nil {
+			c.enableRetries(cisEdgeFunctionClient.Service)
+			cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisEdgeFunctionClient, err
+	})
 
 	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
-			session.cisSSLErr)
-	}
-	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisSSLClient = newLazyClient(func() (*cissslv1.SslCertificateApiV1, error) {
+		cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisSSLClient, err := cissslv1.NewSslCertificateApiV1(cisSSLOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
+				err)
+		}
+		if cisSSLClient != nil && cisSSLClient.Service != nil {
+			c.enableRetries(cisSSLClient.Service)
+			cisSSLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisSSLClient, err
+	})
 
 	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
-			session.cisWAFPackageErr)
-	}
-	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisWAFPackageClient = newLazyClient(func() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+		cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisWAFPackageClient, err := ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
+				err)
+		}
+		if cisWAFPackageClient != nil && cisWAFPackageClient.Service != nil {
+			c.enableRetries(cisWAFPackageClient.Service)
+			cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisWAFPackageClient, err
+	})
 
 	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
-			session.cisDomainSettingsErr)
-	}
-	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisDomainSettingsClient = newLazyClient(func() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+		cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisDomainSettingsClient, err := cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
+				err)
+		}
+		if cisDomainSettingsClient != nil && cisDomainSettingsClient.Service != nil {
+			c.enableRetries(cisDomainSettingsClient.Service)
+			cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisDomainSettingsClient, err
+	})
 
 	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
-			session.cisRoutingErr)
-	}
-	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisRoutingClient = newLazyClient(func() (*cisroutingv1.RoutingV1, error) {
+		cisRoutingOpt := &cisroutingv1.RoutingV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisRoutingClient, err := cisroutingv1.NewRoutingV1(cisRoutingOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
+				err)
+		}
+		if cisRoutingClient != nil && cisRoutingClient.Service != nil {
+			c.enableRetries(cisRoutingClient.Service)
+			cisRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisRoutingClient, err
+	})
 
 	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
-			session.cisWAFGroupErr)
-	}
-	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisWAFGroupClient = newLazyClient(func() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+		cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisWAFGroupClient, err := ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
+				err)
+		}
+		if cisWAFGroupClient != nil && cisWAFGroupClient.Service != nil {
+			c.enableRetries(cisWAFGroupClient.Service)
+			cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisWAFGroupClient, err
+	})
 
 	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
-			session.cisCacheErr)
-	}
-	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisCacheClient = newLazyClient(func() (*ciscachev1.CachingApiV1, error) {
+		cisCacheOpt := &ciscachev1.CachingApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisCacheClient, err := ciscachev1.NewCachingApiV1(cisCacheOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
+				err)
+		}
+		if cisCacheClient != nil && cisCacheClient.Service != nil {
+			c.enableRetries(cisCacheClient.Service)
+			cisCacheClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisCacheClient, err
+	})
 
 	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
-			session.cisCustomPageErr)
-	}
-	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisCustomPageClient = newLazyClient(func() (*ciscustompagev1.CustomPagesV1, error) {
+		cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisCustomPageClient, err := ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
+				err)
+		}
+		if cisCustomPageClient != nil && cisCustomPageClient.Service != nil {
+			c.enableRetries(cisCustomPageClient.Service)
+			cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisCustomPageClient, err
+	})
 
 	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
-			session.cisAccessRuleErr)
-	}
-	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisAccessRuleClient = newLazyClient(func() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+		cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisAccessRuleClient, err := cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
+				err)
+		}
+		if cisAccessRuleClient != nil && cisAccessRuleClient.Service != nil {
+			c.enableRetries(cisAccessRuleClient.Service)
+			cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisAccessRuleClient, err
+	})
 
 	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-			session.cisUARuleErr)
-	}
-	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisUARuleClient = newLazyClient(func() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+		cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisUARuleClient, err := cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
+				err)
+		}
+		if cisUARuleClient != nil && cisUARuleClient.Service != nil {
+			c.enableRetries(cisUARuleClient.Service)
+			cisUARuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisUARuleClient, err
+	})
 
 	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-			session.cisLockdownErr)
-	}
-	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisLockdownClient = newLazyClient(func() (*cislockdownv1.ZoneLockdownV1, error) {
+		cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisLockdownClient, err := cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
+				err)
+		}
+		if cisLockdownClient != nil && cisLockdownClient.Service != nil {
+			c.enableRetries(cisLockdownClient.Service)
+			cisLockdownClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisLockdownClient, err
+	})
 
 	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
-			session.cisRangeAppErr)
-	}
-	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisRangeAppClient = newLazyClient(func() (*cisrangeappv1.RangeApplicationsV1, error) {
+		cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisRangeAppClient, err := cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
+				err)
+		}
+		if cisRangeAppClient != nil && cisRangeAppClient.Service != nil {
+			c.enableRetries(cisRangeAppClient.Service)
+			cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisRangeAppClient, err
+	})
 
 	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisWAFRuleClient = newLazyClient(func() (*ciswafrulev1.WafRulesApiV1, error) {
+		cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisWAFRuleClient, err := ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while configuring CIS WAF Rules service: %s",
+				err)
+		}
+		if cisWAFRuleClient != nil && cisWAFRuleClient.Service != nil {
+			c.enableRetries(cisWAFRuleClient.Service)
+			cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisWAFRuleClient, err
+	})
 
 	// IBM Network CIS LogpushJobs
-	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
-	if session.cisLogpushJobsErr != nil {
-		session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
-			session.cisLogpushJobsErr)
-	}
-	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisLogpushJobsClient = newLazyClient(func() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+		cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Dataset:       core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisLogpushJobsClient, err := cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
+				err)
+		}
+		if cisLogpushJobsClient != nil && cisLogpushJobsClient.Service != nil {
+			c.enableRetries(cisLogpushJobsClient.Service)
+			cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisLogpushJobsClient, err
+	})
 
 	// IBM MTLS Session
-	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
-	if session.cisMtlsErr != nil {
-		session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
-			session.cisMtlsErr)
-	}
-	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisMtlsClient = newLazyClient(func() (*cismtlsv1.MtlsV1, error) {
+		cisMtlsOpt := &cismtlsv1.MtlsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisMtlsClient, err := cismtlsv1.NewMtlsV1(cisMtlsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
+				err)
+		}
+		if cisMtlsClient != nil && cisMtlsClient.Service != nil {
+			c.enableRetries(cisMtlsClient.Service)
+			cisMtlsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisMtlsClient, err
+	})
 
 	// IBM Bot Management
-	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
-	if session.cisBotManagementErr != nil {
-		session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
-			session.cisBotManagementErr)
-	}
-	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisBotManagementClient = newLazyClient(func() (*cisbotmanagementv1.BotManagementV1, error) {
+		cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisBotManagementClient, err := cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
+				err)
+		}
+		if cisBotManagementClient != nil && cisBotManagementClient.Service != nil {
+			c.enableRetries(cisBotManagementClient.Service)
+			cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisBotManagementClient, err
+	})
 
 	// IBM Bot Analytics
-	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
-	if session.cisBotAnalyticsErr != nil {
-		session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
-			session.cisBotAnalyticsErr)
-	}
-	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisBotAnalyticsClient = newLazyClient(func() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+		cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		cisBotAnalyticsClient, err := cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
+				err)
+		}
+		if cisBotAnalyticsClient != nil && cisBotAnalyticsClient.Service != nil {
+			c.enableRetries(cisBotAnalyticsClient.Service)
+			cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisBotAnalyticsClient, err
+	})
 
 	// IBM Network CIS Webhooks
-	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
-	if session.cisWebhooksErr != nil {
-		session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
-			session.cisWebhooksErr)
-	}
-	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisWebhooksClient = newLazyClient(func() (*ciswebhooksv1.WebhooksV1, error) {
+		cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		cisWebhooksClient, err := ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
+				err)
+		}
+		if cisWebhooksClient != nil && cisWebhooksClient.Service != nil {
+			c.enableRetries(cisWebhooksClient.Service)
+			cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisWebhooksClient, err
+	})
 	// IBM Network CIS Filters
-	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
-	if session.cisFiltersErr != nil {
-		session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
-			session.cisFiltersErr)
-	}
-	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisFiltersClient = newLazyClient(func() (*cisfiltersv1.FiltersV1, error) {
+		cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		cisFiltersClient, err := cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
+				err)
+		}
+		if cisFiltersClient != nil && cisFiltersClient.Service != nil {
+			c.enableRetries(cisFiltersClient.Service)
+			cisFiltersClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisFiltersClient, err
+	})
 
 	// IBM Network CIS Firewall rules
-	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
-	if session.cisFirewallRulesErr != nil {
-		session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
-			session.cisFirewallRulesErr)
-	}
-	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisFirewallRulesClient = newLazyClient(func() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+		cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		cisFirewallRulesClient, err := cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
+				err)
+		}
+		if cisFirewallRulesClient != nil && cisFirewallRulesClient.Service != nil {
+			c.enableRetries(cisFirewallRulesClient.Service)
+			cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisFirewallRulesClient, err
+	})
 
 	// IBM Network CIS Authenticated Origin Pull
-	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-
-	session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
-	if session.cisOriginAuthPullErr != nil {
-		session.cisOriginAuthPullErr = fmt.Errorf(
-			"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
-			session.cisOriginAuthPullErr)
-	}
-	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.cisOriginAuthClient = newLazyClient(func() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+		cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+		cisOriginAuthClient, err := cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
+		if err != nil {
+			err = fmt.Errorf(
+				"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
+				err)
+		}
+		if cisOriginAuthClient != nil && cisOriginAuthClient.Service != nil {
+			c.enableRetries(cisOriginAuthClient.Service)
+			cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return cisOriginAuthClient, err
+	})
 
 	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
@@ -2951,21 +3019,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
 	}
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
-	}
-	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamIdentityAPI = iamIdentityClient
+	session.iamIdentityAPI = newLazyClient(func() (*iamidentity.IamIdentityV1, error) {
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		}
+		iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
+		}
+		if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+			c.enableRetries(iamIdentityClient.Service)
+			iamIdentityClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return iamIdentityClient, err
+	})
 
 	// IAM POLICY MANAGEMENT Service
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
@@ -2979,21 +3049,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
 	}
-	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
-	}
-	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
-	if err != nil {
-		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
-	}
-	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamPolicyManagementAPI = iamPolicyManagementClient
+	session.iamPolicyManagementAPI = newLazyClient(func() (*iampolicymanagement.IamPolicyManagementV1, error) {
+		iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		}
+		iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
+		}
+		if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+			c.enableRetries(iamPolicyManagementClient.Service)
+			iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return iamPolicyManagementClient, err
+	})
 
 	// IAM ACCESS GROUP
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
@@ -3007,21 +3079,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
 	}
-	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
-	}
-	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
-	if err != nil {
-		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
-	}
-	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamAccessGroupsAPI = iamAccessGroupsClient
+	session.iamAccessGroupsAPI = newLazyClient(func() (*iamaccessgroups.IamAccessGroupsV2, error) {
+		iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		}
+		iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
+		}
+		if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+			c.enableRetries(iamAccessGroupsClient.Service)
+			iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return iamAccessGroupsClient, err
+	})
 
 	// RESOURCE MANAGEMENT Service
 	rmURL := resourcemanager.DefaultServiceURL
@@ -3043,41 +3117,46 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
 	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
+	session.resourceManagerAPI = newLazyClient(func() (*resourcemanager.ResourceManagerV2, error) {
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		}
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
+		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			c.enableRetries(resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return resourceManagerClient, err
+	})
 
 	// CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
 	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
-	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.ibmCloudShellClient = newLazyClient(func() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		}
+		ibmCloudShellClient, err := ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if ibmCloudShellClient != nil && ibmCloudShellClient.Service != nil {
+			c.enableRetries(ibmCloudShellClient.Service)
+			ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return ibmCloudShellClient, err
+	})
 
 	// ENTERPRISE Service
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
@@ -3100,21 +3179,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
 	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
+	session.enterpriseManagementClient = newLazyClient(func() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		}
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
+		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			c.enableRetries(enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return enterpriseManagementClient, err
+	})
 
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
@@ -3136,21 +3217,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
 	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
+	session.resourceControllerAPI = newLazyClient(func() (*resourcecontroller.ResourceControllerV2, error) {
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		}
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			c.enableRetries(resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return resourceControllerClient, err
+	})
 
 	// SECRETS MANAGER Service V2
 	// Construct an "options" struct for creating the service client.
@@ -3161,23 +3244,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
 	}
 
-	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
-		Authenticator: authenticator,
-		URL:           smBaseUrl,
-	}
-
-	// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
-	if err == nil {
+	session.secretsManagerClient = newLazyClient(func() (*secretsmanagerv2.SecretsManagerV2, error) {
+		secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: authenticator,
+			URL:           smBaseUrl,
+		}
+		// Construct the service client.
+		secretsManagerClient, err := secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
+		if err != nil {
+			return secretsManagerClient, fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(secretsManagerClient.Service)
 		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
+		secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
-	}
+		return secretsManagerClient, nil
+	})
 
 	// SATELLITE Service
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
@@ -3187,22 +3271,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
 	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
-	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
+	session.satelliteClient = newLazyClient(func() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+			Authenticator: authenticator,
+		}
+		satelliteClient, err := kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
+		}
 
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// Enable retries for API calls
+		if satelliteClient != nil && satelliteClient.Service != nil {
+			c.enableRetries(satelliteClient.Service)
+			satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return satelliteClient, err
+	})
 
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
@@ -3213,103 +3300,115 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
 	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
-	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.satelliteLinkClient = newLazyClient(func() (*satellitelinkv1.SatelliteLinkV1, error) {
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+			Authenticator: authenticator,
+		}
+		satelliteLinkClient, err := satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if satelliteLinkClient != nil && satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			c.enableRetries(satelliteLinkClient.Service)
+			// Add custom header for analytics
+			satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return satelliteLinkClient, err
+	})
 
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
-	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.esSchemaRegistryClient = newLazyClient(func() (*schemaregistryv1.SchemaregistryV1, error) {
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
+		}
+		esSchemaRegistryClient, err := schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			err = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if esSchemaRegistryClient != nil && esSchemaRegistryClient.Service != nil {
+			c.enableRetries(esSchemaRegistryClient.Service)
+			esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		return esSchemaRegistryClient, err
+	})
 
-	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+	session.cdToolchainClient = newLazyClient(func() (*cdtoolchainv2.CdToolchainV2, error) {
+		// Construct an "options" struct for creating the service client.
+		var cdToolchainClientURL string
+		var urlErr error
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdToolchainClientURL, urlErr = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
+			if urlErr != nil && c.Visibility == "public-and-private" {
+				cdToolchainClientURL, urlErr = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			cdToolchainClientURL, urlErr = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+		}
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
 		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
 
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
+		// Construct the service client.
+		cdToolchainClient, err := cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
+		if err != nil {
+			return cdToolchainClient, fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(cdToolchainClient.Service)
 		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
+		cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
+		if urlErr != nil {
+			return cdToolchainClient, fmt.Errorf("Error occurred while configuring Toolchain service: %q", urlErr)
+		}
+		return cdToolchainClient, nil
+	})
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+	session.cdTektonPipelineClient = newLazyClient(func() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+		// Construct an "options" struct for creating the tekton pipeline service client.
+		var cdTektonPipelineClientURL string
+		var urlErr error
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdTektonPipelineClientURL, urlErr = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
+			if urlErr != nil && c.Visibility == "public-and-private" {
+				cdTektonPipelineClientURL, urlErr = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			cdTektonPipelineClientURL, urlErr = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+		}
+		if urlErr != nil {
+			cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+		}
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		}
+		// Construct the service client.
+		cdTektonPipelineClient, err := cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err != nil {
+			return cdTektonPipelineClient, fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(cdTektonPipelineClient.Service)
 		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+		cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
-	}
+		return cdTektonPipelineClient, nil
+	})
 
 	// MQ Cloud Service Configuration
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
@@ -3320,45 +3419,47 @@ func (c *Config) ClientSession() (interface{}, error) {
 		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
 	}
 	accept_language := os.Getenv("IBMCLOUD_MQCLOUD_ACCEPT_LANGUAGE")
-	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
-		Authenticator:  authenticator,
-		AcceptLanguage: core.StringPtr(accept_language),
-		URL:            EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
-	}
-
-	// Construct the service client for MQ Cloud.
-	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
-	if err == nil {
+	session.mqcloudClient = newLazyClient(func() (*mqcloudv1.MqcloudV1, error) {
+		mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
+			Authenticator:  authenticator,
+			AcceptLanguage: core.StringPtr(accept_language),
+			URL:            c.endpointFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
+		}
+		// Construct the service client for MQ Cloud.
+		mqcloudClient, err := mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
+		if err != nil {
+			return mqcloudClient, fmt.Errorf("Error occurred while configuring MQ on Cloud service: %q", err)
+		}
 		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(mqcloudClient.Service)
 		// Add custom header for analytics
-		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
+		mqcloudClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.mqcloudClientErr = fmt.Errorf("Error occurred while configuring MQ on Cloud service: %q", err)
-	}
+		return mqcloudClient, nil
+	})
 
 	// VMware as a Service
 	// Construct the service options.
 	vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
-	vmwareClientOptions := &vmwarev1.VmwareV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"VMWARE_URL"}, vmwareURL),
-	}
-
-	// Construct the service client.
-	session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
-	if err == nil {
+	session.vmwareClient = newLazyClient(func() (*vmwarev1.VmwareV1, error) {
+		vmwareClientOptions := &vmwarev1.VmwareV1Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"VMWARE_URL"}, vmwareURL),
+		}
+		// Construct the service client.
+		vmwareClient, err := vmwarev1.NewVmwareV1(vmwareClientOptions)
+		if err != nil {
+			return vmwareClient, fmt.Errorf("Error occurred while configuring VMware as a Service API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(vmwareClient.Service)
 		// Add custom header for analytics
-		session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+		vmwareClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.vmwareClientErr = fmt.Errorf("Error occurred while configuring VMware as a Service API service: %q", err)
-	}
+		return vmwareClient, nil
+	})
 
 	// Construct the service options.
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
@@ -3368,28 +3469,34 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
 	}
-	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
-	}
-
-	// Construct the service client.
-	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
-	if err == nil {
+	session.codeEngineClient = newLazyClient(func() (*codeengine.CodeEngineV2, error) {
+		codeEngineClientOptions := &codeengine.CodeEngineV2Options{
+			Authenticator: authenticator,
+			URL:           c.endpointFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
+		}
+		// Construct the service client.
+		codeEngineClient, err := codeengine.NewCodeEngineV2(codeEngineClientOptions)
+		if err != nil {
+			return codeEngineClient, fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
+		}
 		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries(codeEngineClient.Service)
 		// Add custom header for analytics
-		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
+		codeEngineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
-	} else {
-		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
-	}
+		return codeEngineClient, nil
+	})
 
 	if os.Getenv("TF_LOG") != "" {
 		logDestination := log.Writer()
 		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+		logLevel := core.LevelDebug
+		if traceLoggingEnabled() {
+			// The requests and responses are dumped by the redacting transport
+			logLevel = core.LevelInfo
+		}
+		core.SetLogger(core.NewLogger(logLevel, goLogger, goLogger))
 	}
 
 	// setting UserAgent for vpc-go-sdk common
@@ -3406,6 +3513,12 @@ func CreateVersionDate() *string {
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
 
+	if c.usesCRToken() {
+		if err := c.authenticateCRToken(); err != nil {
+			return nil, err
+		}
+	}
+
 	softlayerSession := &slsession.Session{
 		Endpoint:  c.SoftLayerEndpointURL,
 		Timeout:   c.SoftLayerTimeout,
@@ -3449,10 +3562,13 @@ func newSession(c *Config) (*Session, error) {
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
 			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			Visibility:    c.sessionVisibility(),
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
+		if c.usesCRToken() {
+			bmxConfig.HTTPClient = c.crTokenHTTPClient()
+		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
@@ -3472,7 +3588,7 @@ func newSession(c *Config) (*Session, error) {
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
 			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			Visibility:    c.sessionVisibility(),
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		}
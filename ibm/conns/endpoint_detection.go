@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	"net"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PrivateEndpointProbeTimeout bounds the time spent checking that a private
+// endpoint is reachable when the provider visibility is "auto".
+const PrivateEndpointProbeTimeout = 3 * time.Second
+
+// endpointProber checks whether private endpoints can be reached from the
+// environment running the provider. Results are cached per host for the
+// lifetime of the session.
+type endpointProber struct {
+	mu     sync.Mutex
+	probes map[string]*endpointProbe
+	dial   func(address string) error
+}
+
+type endpointProbe struct {
+	once      sync.Once
+	reachable bool
+}
+
+func newEndpointProber() *endpointProber {
+	return &endpointProber{
+		probes: map[string]*endpointProbe{},
+		dial: func(address string) error {
+			conn, err := net.DialTimeout("tcp", address, PrivateEndpointProbeTimeout)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// isReachable reports whether a TCP connection can be opened to host, which
+// defaults to the HTTPS port.
+func (p *endpointProber) isReachable(host string) bool {
+	p.mu.Lock()
+	probe, ok := p.probes[host]
+	if !ok {
+		probe = &endpointProbe{}
+		p.probes[host] = probe
+	}
+	p.mu.Unlock()
+
+	probe.once.Do(func() {
+		address := host
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			address = net.JoinHostPort(host, "443")
+		}
+		if err := p.dial(address); err != nil {
+			log.Printf("[INFO] Private endpoint %s is not reachable, falling back to the public endpoint: %s", host, err)
+			return
+		}
+		probe.reachable = true
+	})
+	return probe.reachable
+}
+
+// publicHost returns the public counterpart of a private endpoint host, e.g.
+// us-south.iaas.cloud.ibm.com for us-south.private.iaas.cloud.ibm.com. ok is
+// false if host is not a private endpoint.
+func publicHost(host string) (public string, ok bool) {
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		if label == "private" && i < len(labels)-1 {
+			return strings.Join(append(labels[:i:i], labels[i+1:]...), "."), true
+		}
+	}
+	return host, false
+}
+
+// publicEndpoint returns endpoint with its host replaced by the public one
+// when endpoint is a private endpoint which can't be reached.
+func (p *endpointProber) publicEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	public, ok := publicHost(u.Host)
+	if !ok || p.isReachable(u.Host) {
+		return endpoint
+	}
+	u.Host = public
+	return u.String()
+}
+
+// autoEndpointTransport sends the requests for unreachable private endpoints
+// to the public endpoint of the service instead.
+type autoEndpointTransport struct {
+	prober    *endpointProber
+	transport gohttp.RoundTripper
+}
+
+func (t *autoEndpointTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	public, ok := publicHost(req.URL.Host)
+	if !ok || t.prober.isReachable(req.URL.Host) {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.URL.Host = public
+	req.Host = public
+	return t.transport.RoundTrip(req)
+}
+
+// detectVisibility prepares the "auto" visibility: the endpoints are built as
+// for "public-and-private", so that every service uses its private endpoint if
+// it has one and its public endpoint otherwise, and every private endpoint
+// which can't be reached falls back to its public counterpart.
+func (c *Config) detectVisibility() {
+	if c.Visibility != "auto" {
+		return
+	}
+	if c.endpointProber == nil {
+		c.endpointProber = newEndpointProber()
+	}
+	c.Visibility = "public-and-private"
+}
+
+// autoEndpoint returns the endpoint to use for a client which doesn't send its
+// requests through wrapTransport, e.g. the IAM token endpoint.
+func (c *Config) autoEndpoint(endpoint string) string {
+	if c.endpointProber == nil {
+		return endpoint
+	}
+	return c.endpointProber.publicEndpoint(endpoint)
+}
+
+// sessionVisibility returns the visibility of the Bluemix session, whose
+// endpoints can't fall back per service: "private" if the
+// private IAM endpoint can be reached, "public" otherwise.
+func (c *Config) sessionVisibility() string {
+	if c.endpointProber == nil {
+		return c.Visibility
+	}
+	if c.endpointProber.isReachable("private.iam." + cloudEndpoint) {
+		return "private"
+	}
+	return "public"
+}
+
+// withAutoEndpoints wraps transport so that requests for unreachable private
+// endpoints are sent to the public ones when the visibility is "auto".
+func (c *Config) withAutoEndpoints(transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if c.endpointProber == nil {
+		return transport
+	}
+	if transport == nil {
+		transport = gohttp.DefaultTransport
+	}
+	return &autoEndpointTransport{prober: c.endpointProber, transport: transport}
+}
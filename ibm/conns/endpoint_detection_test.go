@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"errors"
+	gohttp "net/http"
+	"testing"
+)
+
+func TestPublicHost(t *testing.T) {
+	cases := map[string]string{
+		"us-south.private.iaas.cloud.ibm.com": "us-south.iaas.cloud.ibm.com",
+		"private.iam.cloud.ibm.com":           "iam.cloud.ibm.com",
+		"private.us-south.kms.cloud.ibm.com":  "us-south.kms.cloud.ibm.com",
+	}
+	for private, expected := range cases {
+		if public, ok := publicHost(private); !ok || public != expected {
+			t.Errorf("publicHost(%q) = %q, %t, expected %q", private, public, ok, expected)
+		}
+	}
+	if _, ok := publicHost("us-south.iaas.cloud.ibm.com"); ok {
+		t.Errorf("expected a public host not to be reported as private")
+	}
+}
+
+func TestAutoEndpointTransport(t *testing.T) {
+	dials := map[string]int{}
+	prober := newEndpointProber()
+	prober.dial = func(address string) error {
+		dials[address]++
+		if address == "us-south.private.iaas.cloud.ibm.com:443" {
+			return errors.New("i/o timeout")
+		}
+		return nil
+	}
+
+	var hosts []string
+	c := &Config{endpointProber: prober}
+	transport := c.withAutoEndpoints(roundTripFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		hosts = append(hosts, req.URL.Host)
+		return &gohttp.Response{StatusCode: 200}, nil
+	}))
+
+	for _, url := range []string{
+		"https://us-south.private.iaas.cloud.ibm.com/v1/vpcs",
+		"https://us-south.private.iaas.cloud.ibm.com/v1/subnets",
+		"https://private.iam.cloud.ibm.com/v1/apikeys",
+		"https://iam.cloud.ibm.com/v1/apikeys",
+	} {
+		req, _ := gohttp.NewRequest("GET", url, nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expected := []string{"us-south.iaas.cloud.ibm.com", "us-south.iaas.cloud.ibm.com", "private.iam.cloud.ibm.com", "iam.cloud.ibm.com"}
+	for i := range expected {
+		if hosts[i] != expected[i] {
+			t.Errorf("request %d: expected host %q, got %q", i, expected[i], hosts[i])
+		}
+	}
+	if dials["us-south.private.iaas.cloud.ibm.com:443"] != 1 {
+		t.Errorf("expected the reachability of a host to be cached, got %d probes", dials["us-south.private.iaas.cloud.ibm.com:443"])
+	}
+	if c.autoEndpoint("https://us-south.private.iaas.cloud.ibm.com/v1") != "https://us-south.iaas.cloud.ibm.com/v1" {
+		t.Errorf("expected the unreachable endpoint to fall back to the public one")
+	}
+}
+
+func TestDetectVisibility(t *testing.T) {
+	c := &Config{Visibility: "public"}
+	c.detectVisibility()
+	if c.endpointProber != nil || c.Visibility != "public" {
+		t.Fatalf("expected the visibility not to change")
+	}
+	c = &Config{Visibility: "auto"}
+	c.detectVisibility()
+	if c.endpointProber == nil || c.Visibility != "public-and-private" {
+		t.Fatalf("expected the auto visibility to select the private endpoint per service")
+	}
+}
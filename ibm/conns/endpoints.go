@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sort"
+)
+
+// EndpointKeys maps the service names accepted in the provider `endpoints` block
+// to the environment variable / endpoints file key used to override the
+// endpoint of that service.
+var EndpointKeys = map[string]string{
+	"app_config":                 "IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"cd_tekton_pipeline":         "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"cd_toolchain":               "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"code_engine":                "IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos":                        "IBMCLOUD_COS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"databases":                  "IBMCLOUD_DATABASES_API_ENDPOINT",
+	"direct_link":                "IBMCLOUD_DL_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"kms":                        "IBMCLOUD_KP_API_ENDPOINT",
+	"logs":                       "IBMCLOUD_LOGS_API_ENDPOINT",
+	"metrics_router":             "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"mqcloud":                    "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"power":                      "IBMCLOUD_PI_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"project":                    "IBMCLOUD_PROJECT_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"scc":                        "IBMCLOUD_SCC_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"usage_reports":              "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// EndpointServices returns the sorted list of service names supported in the
+// provider `endpoints` block.
+func EndpointServices() []string {
+	services := make([]string, 0, len(EndpointKeys))
+	for service := range EndpointKeys {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// endpointOverride returns the endpoint configured for the environment
+// variable / endpoints file key in the provider `endpoints` block. Empty URLs
+// are ignored.
+func endpointOverride(endpoints map[string]string, key string) (string, bool) {
+	for service, url := range endpoints {
+		if EndpointKeys[service] == key && url != "" {
+			return url, true
+		}
+	}
+	return "", false
+}
+
+// endpointFallBack returns the first endpoint of the provider `endpoints`
+// block matching envs, then the value of the first environment variable that
+// is set, and defaultValue otherwise.
+func endpointFallBack(endpoints map[string]string, envs []string, defaultValue string) string {
+	for _, k := range envs {
+		if v, ok := endpointOverride(endpoints, k); ok {
+			return v
+		}
+	}
+	return EnvFallBack(envs, defaultValue)
+}
+
+func (c *Config) endpointFallBack(envs []string, defaultValue string) string {
+	return endpointFallBack(c.Endpoints, envs, defaultValue)
+}
+
+func (session clientSession) endpointFallBack(envs []string, defaultValue string) string {
+	return endpointFallBack(session.endpoints, envs, defaultValue)
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"testing"
+)
+
+func TestEndpointFallBack(t *testing.T) {
+	c := &Config{
+		Endpoints: map[string]string{
+			"vpc":     "https://us-south.private.iaas.cloud.ibm.com/v1",
+			"unknown": "https://example.com",
+			"iam":     "",
+		},
+	}
+
+	if got := c.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, "https://default"); got != "https://us-south.private.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("expected endpoint override, got %s", got)
+	}
+	if got := c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://default"); got != "https://default" {
+		t.Fatalf("expected empty override to be ignored, got %s", got)
+	}
+
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://env")
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", "https://iam-env")
+	if got := c.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, "https://default"); got != "https://us-south.private.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("expected endpoint override to take precedence, got %s", got)
+	}
+	if got := c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://default"); got != "https://iam-env" {
+		t.Fatalf("expected environment variable, got %s", got)
+	}
+
+	other := &Config{}
+	if got := other.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, "https://default"); got != "https://env" {
+		t.Fatalf("expected overrides of another provider to be ignored, got %s", got)
+	}
+}
+
+func TestEndpointServices(t *testing.T) {
+	services := EndpointServices()
+	if len(services) != len(EndpointKeys) {
+		t.Fatalf("expected %d services, got %d", len(EndpointKeys), len(services))
+	}
+	for i := 1; i < len(services); i++ {
+		if services[i-1] > services[i] {
+			t.Fatalf("services are not sorted: %v", services)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sync"
+)
+
+// lazyClient defers the construction of a service client until it is first
+// requested. The result (client and error) of the construction is cached and
+// shared by every copy of the clientSession holding the same lazyClient.
+type lazyClient[T any] struct {
+	once   sync.Once
+	init   func() (T, error)
+	client T
+	err    error
+}
+
+func newLazyClient[T any](init func() (T, error)) *lazyClient[T] {
+	return &lazyClient[T]{init: init}
+}
+
+// get constructs the client on first use. A nil lazyClient is a client which
+// was never configured because the session has no credentials.
+func (l *lazyClient[T]) get() (T, error) {
+	if l == nil {
+		var client T
+		return client, errEmptyBluemixCredentials
+	}
+	l.once.Do(func() {
+		l.client, l.err = l.init()
+		l.init = nil
+	})
+	return l.client, l.err
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLazyClientInitOnce(t *testing.T) {
+	calls := 0
+	client := newLazyClient(func() (*string, error) {
+		calls++
+		name := "vpc"
+		return &name, nil
+	})
+	if calls != 0 {
+		t.Fatalf("expected client not to be constructed before first use")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := client.get(); err != nil || *got != "vpc" {
+				t.Errorf("unexpected client %v, %v", got, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected client to be constructed once, got %d", calls)
+	}
+}
+
+func TestLazyClientErr(t *testing.T) {
+	configErr := errors.New("config error")
+	client := newLazyClient(func() (*string, error) {
+		return nil, configErr
+	})
+	if _, err := client.get(); err != configErr {
+		t.Fatalf("expected %v, got %v", configErr, err)
+	}
+
+	var unconfigured *lazyClient[*string]
+	if _, err := unconfigured.get(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %v for an unconfigured client, got %v", errEmptyBluemixCredentials, err)
+	}
+
+	sess := clientSession{}
+	if _, err := sess.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %v for an unconfigured session, got %v", errEmptyBluemixCredentials, err)
+	}
+	if _, err := sess.CisZonesV1ClientSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %v for an unconfigured session, got %v", errEmptyBluemixCredentials, err)
+	}
+	if _, err := sess.KeyManagementAPI(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %v for an unconfigured session, got %v", errEmptyBluemixCredentials, err)
+	}
+}
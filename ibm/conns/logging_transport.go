@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	gohttp "net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers whose value is never written to the logs.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Refresh-Token",
+	"X-Auth-Token",
+	"X-Auth-Refresh-Token",
+	"X-Auth-User-Token",
+	"X-Api-Key",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveParameters are the body and query parameters whose value is never
+// written to the logs, e.g. the `api_key` and `token` parameters of the
+// toolchain tool integrations.
+var sensitiveParameters = []string{
+	"access_token",
+	"api_key",
+	"api_token",
+	"apikey",
+	"auth_token",
+	"bind_password",
+	"client_secret",
+	"password",
+	"passphrase",
+	"private_key",
+	"refresh_token",
+	"secret",
+	"secret_key",
+	"token",
+	"webhook",
+}
+
+var (
+	headerRedactor = regexp.MustCompile(`(?im)^(` + strings.Join(sensitiveHeaders, "|") + `):.*$`)
+	jsonRedactor   = regexp.MustCompile(`(?i)"(` + strings.Join(sensitiveParameters, "|") + `)"(\s*):(\s*)"(?:[^"\\]|\\.)*"`)
+	formRedactor   = regexp.MustCompile(`(?i)\b(` + strings.Join(sensitiveParameters, "|") + `)=[^&\s]*`)
+)
+
+// RedactSecrets replaces the value of the sensitive headers and parameters of a
+// dumped HTTP request or response.
+func RedactSecrets(dump string) string {
+	dump = headerRedactor.ReplaceAllString(dump, "${1}: "+redacted)
+	dump = jsonRedactor.ReplaceAllString(dump, `"${1}"${2}:${3}"`+redacted+`"`)
+	return formRedactor.ReplaceAllString(dump, "${1}="+redacted)
+}
+
+// traceLoggingEnabled reports whether the provider runs with TF_LOG=TRACE (or
+// TF_LOG_PROVIDER=TRACE).
+func traceLoggingEnabled() bool {
+	for _, env := range []string{"TF_LOG", "TF_LOG_PROVIDER"} {
+		if strings.EqualFold(os.Getenv(env), "TRACE") {
+			return true
+		}
+	}
+	return false
+}
+
+// redactingTransport logs the full HTTP requests and responses going through
+// transport with their secrets redacted.
+type redactingTransport struct {
+	transport gohttp.RoundTripper
+}
+
+// NewRedactingTransport wraps transport so that requests and responses are
+// logged at TRACE level. The transport is returned as is when TRACE logging
+// is disabled.
+func NewRedactingTransport(transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if !traceLoggingEnabled() {
+		return transport
+	}
+	if _, ok := transport.(*redactingTransport); ok {
+		return transport
+	}
+	if transport == nil {
+		transport = gohttp.DefaultTransport
+	}
+	return &redactingTransport{transport: transport}
+}
+
+func (t *redactingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		log.Printf("[TRACE] IBM Cloud API request:\n%s", RedactSecrets(string(dump)))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[TRACE] IBM Cloud API request %s %s failed: %s", req.Method, req.URL.Redacted(), err)
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpResponse(resp, true); err == nil {
+		log.Printf("[TRACE] IBM Cloud API response:\n%s", RedactSecrets(string(dump)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	dump := "POST /toolchains/abc/tools?apikey=secret-value&limit=10 HTTP/1.1\r\n" +
+		"Host: api.us-south.devops.cloud.ibm.com\r\n" +
+		"Authorization: Bearer eyJraWQiOiIyMDI0\r\n" +
+		"Content-Type: application/json\r\n" +
+		"\r\n" +
+		`{"name":"artifactory","parameters":{"api_key":"abc\"def","Token": "ghp_123","repository_url":"https://example.com"}}`
+
+	redactedDump := RedactSecrets(dump)
+	for _, secret := range []string{"secret-value", "eyJraWQiOiIyMDI0", `abc\"def`, "ghp_123"} {
+		if strings.Contains(redactedDump, secret) {
+			t.Fatalf("secret %q was not redacted:\n%s", secret, redactedDump)
+		}
+	}
+	for _, kept := range []string{"limit=10", "Content-Type: application/json", `"repository_url":"https://example.com"`, `"name":"artifactory"`} {
+		if !strings.Contains(redactedDump, kept) {
+			t.Fatalf("expected %q to be kept:\n%s", kept, redactedDump)
+		}
+	}
+}
+
+func TestNewRedactingTransport(t *testing.T) {
+	t.Setenv("TF_LOG", "DEBUG")
+	t.Setenv("TF_LOG_PROVIDER", "")
+	if transport := NewRedactingTransport(gohttp.DefaultTransport); transport != gohttp.DefaultTransport {
+		t.Fatalf("expected the transport not to be wrapped without TRACE logging")
+	}
+
+	t.Setenv("TF_LOG", "trace")
+	transport := NewRedactingTransport(gohttp.DefaultTransport)
+	if _, ok := transport.(*redactingTransport); !ok {
+		t.Fatalf("expected the transport to be wrapped with TRACE logging")
+	}
+	if NewRedactingTransport(transport) != transport {
+		t.Fatalf("expected the transport not to be wrapped twice")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Preflight checks the configuration of the provider when it is configured,
+// so that wrong credentials, resource group or region are reported once, with
+// a clear message, instead of by the first API call of every resource. It
+// checks that the credentials are valid, that the resource groups of the
+// account can be listed and include the configured resource group, and that
+// the configured region exists.
+//
+// The configuration is not checked when there are no IBM Cloud credentials,
+// as the provider is then only used for classic infrastructure.
+func Preflight(sess ClientSession, c *Config) error {
+	userDetails, err := sess.BluemixUserDetails()
+	if err == errEmptyBluemixCredentials {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] The provider credentials are not valid, check ibmcloud_api_key, iam_token or iam_profile_id: %s", err)
+	}
+
+	var problems []string
+	if err := preflightResourceGroups(sess, userDetails.UserAccount, c.ResourceGroup); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if err := preflightRegion(sess, c.Region); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("[ERROR] The provider configuration is not valid:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// preflightResourceGroups checks that the resource groups of the account can
+// be listed, and that resourceGroup, an ID or a name, is one of them.
+func preflightResourceGroups(sess ClientSession, accountID, resourceGroup string) error {
+	rMgtClient, err := sess.ResourceManagerV2API()
+	if err != nil {
+		return fmt.Errorf("the resource groups can't be listed: %s", err)
+	}
+	groups, resp, err := rMgtClient.ListResourceGroups(&resourcemanager.ListResourceGroupsOptions{
+		AccountID: &accountID,
+	})
+	if err != nil || groups == nil {
+		return fmt.Errorf("the resource groups of the account %s can't be listed, check the access policies of the credentials: %s %s", accountID, err, resp)
+	}
+	if resourceGroup == "" {
+		return nil
+	}
+
+	names := make([]string, 0, len(groups.Resources))
+	for _, group := range groups.Resources {
+		if (group.ID != nil && *group.ID == resourceGroup) || (group.Name != nil && *group.Name == resourceGroup) {
+			return nil
+		}
+		if group.Name != nil {
+			names = append(names, *group.Name)
+		}
+	}
+	return fmt.Errorf("the resource group %s is not one of the accessible resource groups: %s", resourceGroup, strings.Join(names, ", "))
+}
+
+// preflightRegion checks that region is an IBM Cloud region.
+func preflightRegion(sess ClientSession, region string) error {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return fmt.Errorf("the region %s can't be checked: %s", region, err)
+	}
+	regions, resp, err := vpcClient.ListRegions(&vpc.ListRegionsOptions{})
+	if err != nil || regions == nil {
+		return fmt.Errorf("the region %s is not valid or its endpoints can't be reached: %s %s", region, err, resp)
+	}
+
+	names := make([]string, 0, len(regions.Regions))
+	for _, r := range regions.Regions {
+		if r.Name != nil && *r.Name == region {
+			return nil
+		}
+		if r.Name != nil {
+			names = append(names, *r.Name)
+		}
+	}
+	return fmt.Errorf("the region %s is not valid, expected one of: %s", region, strings.Join(names, ", "))
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+type preflightSession struct {
+	ClientSession
+	url string
+}
+
+func (s preflightSession) BluemixUserDetails() (*UserConfig, error) {
+	return &UserConfig{UserAccount: "account"}, nil
+}
+
+func (s preflightSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	return resourcemanager.NewResourceManagerV2(&resourcemanager.ResourceManagerV2Options{
+		URL:           s.url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+func (s preflightSession) VpcV1API() (*vpc.VpcV1, error) {
+	return vpc.NewVpcV1(&vpc.VpcV1Options{
+		URL:           s.url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+func TestPreflight(t *testing.T) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/resource_groups"):
+			fmt.Fprint(w, `{"resources":[{"id":"1234","name":"default"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/regions"):
+			fmt.Fprint(w, `{"regions":[{"name":"us-south"},{"name":"eu-de"}]}`)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	sess := preflightSession{url: server.URL}
+
+	for _, c := range []*Config{
+		{Region: "us-south"},
+		{Region: "eu-de", ResourceGroup: "1234"},
+		{Region: "eu-de", ResourceGroup: "default"},
+	} {
+		if err := Preflight(sess, c); err != nil {
+			t.Errorf("unexpected error for %+v: %s", c, err)
+		}
+	}
+
+	err := Preflight(sess, &Config{Region: "us-sooth", ResourceGroup: "prod"})
+	if err == nil {
+		t.Fatalf("expected the wrong region and resource group to be reported")
+	}
+	if !strings.Contains(err.Error(), "region us-sooth") || !strings.Contains(err.Error(), "resource group prod") {
+		t.Errorf("expected both problems in a single error, got: %s", err)
+	}
+}
+
+func TestPreflightWithoutCredentials(t *testing.T) {
+	sess := clientSession{bmxUserFetchErr: errEmptyBluemixCredentials}
+	if err := Preflight(sess, &Config{Region: "us-south"}); err != nil {
+		t.Errorf("expected the checks to be skipped without IBM Cloud credentials, got: %s", err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"math"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultRetryMaxDelay - upper bound for a single backoff interval
+const DefaultRetryMaxDelay = 30 * time.Second
+
+// TestTransport, when set, is the transport of the HTTP client of every IBM SDK
+// service client, e.g. to record and replay the API calls of the acceptance
+// tests. The SDK clients don't use http.DefaultTransport.
+var TestTransport gohttp.RoundTripper
+
+// enableRetries turns on retries for an IBM SDK service client and replaces the
+// SDK's default backoff with an exponential backoff with jitter, so that
+// parallel requests hitting a rate limit don't retry in lockstep. The transport
+// of the client is also wrapped by wrapTransport.
+func (c *Config) enableRetries(service *core.BaseService) {
+	if TestTransport != nil {
+		service.SetHTTPClient(&gohttp.Client{Transport: TestTransport})
+	}
+	minDelay, maxDelay := c.retryDelays()
+	service.EnableRetries(c.RetryCount, maxDelay)
+	if service.Client == nil {
+		return
+	}
+	if rt, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok && rt.Client != nil {
+		rt.Client.RetryWaitMin = minDelay
+		rt.Client.RetryWaitMax = maxDelay
+		rt.Client.Backoff = ExponentialJitterBackoff
+		// Limit and log every attempt rather than once per retried request.
+		if rt.Client.HTTPClient != nil {
+			rt.Client.HTTPClient.Transport = c.wrapTransport(service, rt.Client.HTTPClient.Transport)
+			return
+		}
+	}
+	service.Client.Transport = c.wrapTransport(service, service.Client.Transport)
+}
+
+// retryDelays returns the base and maximum backoff intervals, falling back to
+// the provider defaults when they were not configured.
+func (c *Config) retryDelays() (time.Duration, time.Duration) {
+	minDelay := c.RetryDelay
+	if minDelay <= 0 {
+		minDelay = RetryAPIDelay
+	}
+	maxDelay := c.RetryMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryMaxDelay
+	}
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+	return minDelay, maxDelay
+}
+
+// ExponentialJitterBackoff implements retryablehttp.Backoff. A Retry-After header
+// sent with a 429 or 503 response takes precedence, otherwise the interval doubles
+// with every attempt starting from min, is capped at max and half of it is randomized.
+func ExponentialJitterBackoff(min, max time.Duration, attemptNum int, resp *gohttp.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == gohttp.StatusTooManyRequests || resp.StatusCode == gohttp.StatusServiceUnavailable) {
+		if s, ok := resp.Header["Retry-After"]; ok {
+			if sleep, err := strconv.ParseInt(s[0], 10, 64); err == nil && sleep >= 0 {
+				return time.Second * time.Duration(sleep)
+			}
+		}
+	}
+
+	backoff := float64(min) * math.Pow(2, float64(attemptNum))
+	if backoff > float64(max) || math.IsInf(backoff, 0) {
+		backoff = float64(max)
+	}
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return time.Duration(backoff)
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"testing"
+	"time"
+)
+
+func TestExponentialJitterBackoff(t *testing.T) {
+	min := 1 * time.Second
+	max := 30 * time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		expected := min << uint(attempt)
+		if expected > max {
+			expected = max
+		}
+		for i := 0; i < 50; i++ {
+			got := ExponentialJitterBackoff(min, max, attempt, nil)
+			if got < expected/2 || got > expected {
+				t.Fatalf("attempt %d: backoff %s outside of [%s, %s]", attempt, got, expected/2, expected)
+			}
+		}
+	}
+}
+
+func TestExponentialJitterBackoffRetryAfter(t *testing.T) {
+	resp := &gohttp.Response{
+		StatusCode: gohttp.StatusTooManyRequests,
+		Header:     gohttp.Header{"Retry-After": []string{"7"}},
+	}
+	if got := ExponentialJitterBackoff(time.Second, 30*time.Second, 3, resp); got != 7*time.Second {
+		t.Fatalf("expected Retry-After to be honored, got %s", got)
+	}
+
+	resp.StatusCode = gohttp.StatusInternalServerError
+	if got := ExponentialJitterBackoff(time.Second, 30*time.Second, 0, resp); got > time.Second {
+		t.Fatalf("expected Retry-After to be ignored for 500, got %s", got)
+	}
+}
+
+func TestConfigRetryDelays(t *testing.T) {
+	c := &Config{}
+	minDelay, maxDelay := c.retryDelays()
+	if minDelay != RetryAPIDelay || maxDelay != DefaultRetryMaxDelay {
+		t.Fatalf("unexpected defaults %s/%s", minDelay, maxDelay)
+	}
+
+	c = &Config{RetryDelay: 10 * time.Second, RetryMaxDelay: 2 * time.Second}
+	minDelay, maxDelay = c.retryDelays()
+	if minDelay != 10*time.Second || maxDelay != 10*time.Second {
+		t.Fatalf("expected max delay to be raised to the base delay, got %s/%s", minDelay, maxDelay)
+	}
+}
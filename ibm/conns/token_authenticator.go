@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+// TokenRefreshWindow is how long before its expiration the IAM access token
+// of the provider is refreshed.
+const TokenRefreshWindow = 5 * time.Minute
+
+// tokenAuthenticator authenticates the IBM SDK clients with the iam_token of
+// the provider, and refreshes it with iam_refresh_token once it is about to
+// expire, so that short-lived tokens minted by CI systems can be used.
+type tokenAuthenticator struct {
+	mu          sync.Mutex
+	accessToken string
+	expiration  time.Time
+	refresher   *core.IamAuthenticator
+}
+
+func newTokenAuthenticator(accessToken, refreshToken, iamURL string) *tokenAuthenticator {
+	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+	authenticator := &tokenAuthenticator{
+		accessToken: accessToken,
+		expiration:  tokenExpiration(accessToken),
+	}
+	if refreshToken != "" {
+		authenticator.refresher = &core.IamAuthenticator{
+			RefreshToken: refreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          iamURL,
+		}
+	}
+	return authenticator
+}
+
+// tokenExpiration returns the expiration of a JWT access token, or the zero
+// time if it can't be read.
+func tokenExpiration(accessToken string) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(accessToken, claims); err != nil {
+		return time.Time{}
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		return time.Unix(int64(exp), 0)
+	}
+	return time.Time{}
+}
+
+func (a *tokenAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_BEARER_TOKEN
+}
+
+func (a *tokenAuthenticator) Validate() error {
+	if a.accessToken == "" && a.refresher == nil {
+		return fmt.Errorf("[ERROR] iam_token or iam_refresh_token must be provided")
+	}
+	return nil
+}
+
+func (a *tokenAuthenticator) Authenticate(request *gohttp.Request) error {
+	token, err := a.token()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token returns the access token, refreshing it first if it expires within
+// TokenRefreshWindow.
+func (a *tokenAuthenticator) token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.refresher == nil || (a.accessToken != "" && time.Until(a.expiration) > TokenRefreshWindow) {
+		return a.accessToken, nil
+	}
+	response, err := a.refresher.RequestToken()
+	if err != nil {
+		if a.accessToken != "" && time.Now().Before(a.expiration) {
+			// Use the current token until it actually expires.
+			return a.accessToken, nil
+		}
+		return "", fmt.Errorf("[ERROR] Error refreshing the IAM access token with iam_refresh_token: %s", err)
+	}
+	a.accessToken = response.AccessToken
+	a.expiration = tokenExpiration(response.AccessToken)
+	if a.expiration.IsZero() && response.Expiration > 0 {
+		a.expiration = time.Unix(response.Expiration, 0)
+	}
+	if response.RefreshToken != "" {
+		// IAM refresh tokens are single use.
+		a.refresher.RefreshToken = response.RefreshToken
+	}
+	return a.accessToken, nil
+}
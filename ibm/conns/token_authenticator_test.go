@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testAccessToken(expiration time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiration.Unix())))
+	return header + "." + payload + ".signature"
+}
+
+func TestTokenAuthenticatorUsesAccessToken(t *testing.T) {
+	accessToken := testAccessToken(time.Now().Add(time.Hour))
+	authenticator := newTokenAuthenticator("Bearer "+accessToken, "refresh", "http://127.0.0.1:0")
+
+	req, _ := gohttp.NewRequest("GET", "https://iam.cloud.ibm.com/v1/apikeys", nil)
+	if err := authenticator.Authenticate(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if req.Header.Get("Authorization") != "Bearer "+accessToken {
+		t.Fatalf("expected the access token to be used until it expires, got %q", req.Header.Get("Authorization"))
+	}
+}
+
+func TestTokenAuthenticatorRefreshesExpiringToken(t *testing.T) {
+	refreshed := testAccessToken(time.Now().Add(time.Hour))
+	var refreshTokens []string
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		r.ParseForm()
+		refreshTokens = append(refreshTokens, r.PostForm.Get("refresh_token"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"refresh-2","token_type":"Bearer","expires_in":3600,"expiration":%d}`,
+			refreshed, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	authenticator := newTokenAuthenticator(testAccessToken(time.Now().Add(time.Minute)), "refresh-1", server.URL)
+	req, _ := gohttp.NewRequest("GET", "https://iam.cloud.ibm.com/v1/apikeys", nil)
+	if err := authenticator.Authenticate(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if req.Header.Get("Authorization") != "Bearer "+refreshed {
+		t.Fatalf("expected the refreshed access token, got %q", req.Header.Get("Authorization"))
+	}
+	if len(refreshTokens) != 1 || refreshTokens[0] != "refresh-1" {
+		t.Fatalf("expected one refresh with the provided refresh token, got %v", refreshTokens)
+	}
+	if authenticator.refresher.RefreshToken != "refresh-2" {
+		t.Fatalf("expected the new refresh token to be kept, got %q", authenticator.refresher.RefreshToken)
+	}
+}
+
+func TestTokenExpirationInvalidToken(t *testing.T) {
+	if !tokenExpiration("not-a-jwt").IsZero() {
+		t.Fatalf("expected no expiration for an invalid token")
+	}
+}
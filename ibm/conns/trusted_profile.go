@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// usesCRToken reports whether the provider authenticates with a trusted
+// profile and the compute resource token of the IKS / Code Engine workload.
+func (c *Config) usesCRToken() bool {
+	return c.CRTokenFile != "" && c.BluemixAPIKey == ""
+}
+
+// iamEndpoint returns the IAM endpoint for the visibility and the region of
+// the provider.
+func (c *Config) iamEndpoint() string {
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if c.Visibility != "public-and-private" {
+		iamURL = FileFallBack(c.EndpointsFile, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+	return c.autoEndpoint(iamURL)
+}
+
+// containerAuthenticator returns the authenticator exchanging the compute
+// resource token for an IAM access token of the trusted profile. The token is
+// refreshed by the authenticator itself, and the authenticator is shared by
+// all the clients of the provider, so that they never run with an expired
+// token.
+func (c *Config) containerAuthenticator() *core.ContainerAuthenticator {
+	if c.crAuthenticator == nil {
+		c.crAuthenticator = &core.ContainerAuthenticator{
+			CRTokenFilename: c.CRTokenFile,
+			IAMProfileID:    c.IAMTrustedProfileID,
+			URL:             c.endpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.iamEndpoint()),
+		}
+	}
+	return c.crAuthenticator
+}
+
+// authenticateCRToken fetches the IAM access token of the trusted profile so
+// that the bluemix-go session, which only understands IAM tokens, can be
+// configured as with the iam_token argument. The requests of the session are
+// then sent through crTokenHTTPClient, which keeps the token up to date.
+func (c *Config) authenticateCRToken() error {
+	if c.IAMTrustedProfileID == "" {
+		return fmt.Errorf("iam_profile_id must be provided with cr_token_file")
+	}
+	authenticator := c.containerAuthenticator()
+	if err := authenticator.Validate(); err != nil {
+		return fmt.Errorf("[ERROR] Error occured while configuring the compute resource authenticator: %q", err)
+	}
+
+	log.Println("Configuring IBM Cloud Session with trusted profile and compute resource token")
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error occured while fetching the IAM token of the trusted profile: %q", err)
+	}
+	c.IAMToken = "Bearer " + token
+	return nil
+}
+
+// crTokenHTTPClient returns the HTTP client of the bluemix-go session when the
+// provider authenticates with a compute resource token.
+func (c *Config) crTokenHTTPClient() *gohttp.Client {
+	return &gohttp.Client{
+		Timeout:   c.BluemixTimeout,
+		Transport: &bearerTokenTransport{authenticator: c.containerAuthenticator(), transport: gohttp.DefaultTransport},
+	}
+}
+
+// bearerTokenTransport replaces the IAM access token of the requests with the
+// current token of the authenticator. The bluemix-go clients send the token
+// they were configured with, and can't refresh the token of a trusted profile.
+type bearerTokenTransport struct {
+	authenticator core.Authenticator
+	transport     gohttp.RoundTripper
+}
+
+func (t *bearerTokenTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return t.transport.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	if err := t.authenticator.Authenticate(req); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
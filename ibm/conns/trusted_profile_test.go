@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestIAMEndpoint(t *testing.T) {
+	cases := []struct {
+		visibility, region, expected string
+	}{
+		{"public", "us-south", "https://iam.cloud.ibm.com"},
+		{"private", "us-south", "https://private.us-south.iam.cloud.ibm.com"},
+		{"private", "eu-de", "https://private.iam.cloud.ibm.com"},
+		{"public-and-private", "us-east", "https://private.us-east.iam.cloud.ibm.com"},
+	}
+	for _, tc := range cases {
+		c := &Config{Visibility: tc.visibility, Region: tc.region}
+		if got := c.iamEndpoint(); got != tc.expected {
+			t.Errorf("%s %s: expected %s, got %s", tc.visibility, tc.region, tc.expected, got)
+		}
+	}
+}
+
+func TestContainerAuthenticator(t *testing.T) {
+	c := &Config{
+		Visibility:          "private",
+		Region:              "eu-de",
+		CRTokenFile:         "/var/run/secrets/tokens/sa-token",
+		IAMTrustedProfileID: "Profile-9942f85e-0b35-4b1f-8d5a-1b3bd9b8d0f7",
+	}
+	if !c.usesCRToken() {
+		t.Fatalf("expected the compute resource token to be used")
+	}
+	authenticator := c.containerAuthenticator()
+	if authenticator != c.containerAuthenticator() {
+		t.Fatalf("expected the authenticator to be shared by the clients")
+	}
+	if authenticator.URL != "https://private.iam.cloud.ibm.com" {
+		t.Fatalf("expected the private IAM endpoint, got %s", authenticator.URL)
+	}
+	if authenticator.IAMProfileID != c.IAMTrustedProfileID || authenticator.CRTokenFilename != c.CRTokenFile {
+		t.Fatalf("unexpected authenticator %+v", authenticator)
+	}
+
+	c.BluemixAPIKey = "apikey" // pragma: allowlist secret
+	if c.usesCRToken() {
+		t.Fatalf("expected the API key to take precedence")
+	}
+}
+
+func TestAuthenticateCRTokenWithoutProfile(t *testing.T) {
+	c := &Config{CRTokenFile: "/var/run/secrets/tokens/sa-token"}
+	if err := c.authenticateCRToken(); err == nil {
+		t.Fatalf("expected an error without iam_profile_id")
+	}
+}
+
+func TestBearerTokenTransport(t *testing.T) {
+	var authorizations []string
+	transport := &bearerTokenTransport{
+		authenticator: &core.BearerTokenAuthenticator{BearerToken: "fresh"},
+		transport: roundTripFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+			authorizations = append(authorizations, req.Header.Get("Authorization"))
+			return &gohttp.Response{StatusCode: 200}, nil
+		}),
+	}
+
+	for _, authorization := range []string{"Bearer stale", "Basic Yng6Yng=", ""} {
+		req, _ := gohttp.NewRequest("GET", "https://containers.cloud.ibm.com/global/v1/clusters", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if req.Header.Get("Authorization") != authorization {
+			t.Fatalf("expected the original request not to be modified")
+		}
+	}
+
+	expected := []string{"Bearer fresh", "Basic Yng6Yng=", ""}
+	for i := range expected {
+		if authorizations[i] != expected[i] {
+			t.Errorf("request %d: expected authorization %q, got %q", i, expected[i], authorizations[i])
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// AccessTags is the name of the access management tags argument.
+	AccessTags = "access_tags"
+	// AccessTagType is the global tagging type of the access management tags.
+	AccessTagType = "access"
+)
+
+// accessTagRegexp matches a key:value access management tag.
+var accessTagRegexp = regexp.MustCompile(`^([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-]):([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-])$`)
+
+// AccessTagsSchema returns the schema of the access_tags argument of a
+// resource which can be tagged through the global tagging API. The resource
+// must also use AccessTagsCustomizeDiff, UpdateAccessTags and ReadAccessTags.
+func AccessTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 128),
+				validation.StringMatch(accessTagRegexp, "must be a key:value access management tag"),
+			),
+		},
+		Set:         ResourceIBMVPCHash,
+		Description: "List of access management tags",
+	}
+}
+
+// AccessTagsCustomizeDiff checks at plan time that the configured access tags
+// exist in the account, as they must be created before being attached.
+func AccessTagsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ResourceValidateAccessTags(diff, meta)
+}
+
+// UpdateAccessTags attaches and detaches the access tags of the resource
+// identified by crn according to the changes of its access_tags argument.
+// It's meant to be called on create and update.
+func UpdateAccessTags(d *schema.ResourceData, meta interface{}, crn string) error {
+	if !d.HasChange(AccessTags) {
+		return nil
+	}
+	oldList, newList := d.GetChange(AccessTags)
+	if err := UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn, "", AccessTagType); err != nil {
+		return fmt.Errorf("[ERROR] Error updating the access tags of %s: %s", crn, err)
+	}
+	return nil
+}
+
+// ReadAccessTags sets the access_tags argument to the access tags attached to
+// the resource identified by crn.
+func ReadAccessTags(d *schema.ResourceData, meta interface{}, crn string) error {
+	accessTags, err := GetGlobalTagsUsingCRN(meta, crn, "", AccessTagType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the access tags of %s: %s", crn, err)
+	}
+	return d.Set(AccessTags, accessTags)
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccessTagsSchemaValidation(t *testing.T) {
+	validateFunc := AccessTagsSchema().Elem.(*schema.Schema).ValidateFunc
+	for _, tag := range []string{"env:dev", "project:my project", "team_1:a.b-c"} {
+		if _, errs := validateFunc(tag, AccessTags); len(errs) != 0 {
+			t.Errorf("expected %q to be a valid access tag, got %v", tag, errs)
+		}
+	}
+	for _, tag := range []string{"env", "env:", ":dev", "env:dev:prod", "env: dev"} {
+		if _, errs := validateFunc(tag, AccessTags); len(errs) == 0 {
+			t.Errorf("expected %q to be an invalid access tag", tag)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// ReservedCIDRs are the ranges used by the IBM Cloud platform which can't be
+// used as address prefixes or subnets of a VPC.
+var ReservedCIDRs = []string{
+	"127.0.0.0/8",    // loopback
+	"161.26.0.0/16",  // IaaS service endpoints
+	"166.8.0.0/14",   // cloud service endpoints
+	"169.254.0.0/16", // link-local
+	"224.0.0.0/4",    // multicast
+}
+
+// CIDRSubnets carves `zones` subnets out of the base IPv4 CIDR, each extending
+// the prefix of base by newbits. Subnets overlapping one of the ReservedCIDRs
+// are skipped, so the returned subnets can be used as is in a multi-zone VPC.
+func CIDRSubnets(base string, zones, newbits int) ([]string, error) {
+	_, network, err := net.ParseCIDR(base)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid base CIDR %q: %s", base, err)
+	}
+	if network.IP.To4() == nil {
+		return nil, fmt.Errorf("[ERROR] Invalid base CIDR %q: only IPv4 is supported", base)
+	}
+	if zones < 1 {
+		return nil, fmt.Errorf("[ERROR] Invalid number of zones %d: must be at least 1", zones)
+	}
+	prefix, _ := network.Mask.Size()
+	if newbits < 0 || prefix+newbits > 32 {
+		return nil, fmt.Errorf("[ERROR] Invalid newbits %d: the prefix of %q can't be extended to /%d", newbits, base, prefix+newbits)
+	}
+
+	reserved := make([]*net.IPNet, 0, len(ReservedCIDRs))
+	for _, cidr := range ReservedCIDRs {
+		_, r, _ := net.ParseCIDR(cidr)
+		reserved = append(reserved, r)
+	}
+
+	start := binary.BigEndian.Uint32(network.IP.To4())
+	size := uint64(1) << uint(32-prefix-newbits)
+	count := uint64(1) << uint(newbits)
+	subnets := make([]string, 0, zones)
+	for i := uint64(0); i < count && len(subnets) < zones; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, start+uint32(i*size))
+		subnet := &net.IPNet{IP: ip, Mask: net.CIDRMask(prefix+newbits, 32)}
+		if overlapsAny(subnet, reserved) {
+			continue
+		}
+		subnets = append(subnets, subnet.String())
+	}
+	if len(subnets) < zones {
+		return nil, fmt.Errorf("[ERROR] %q only has room for %d /%d subnets outside of the IBM reserved ranges, %d requested", base, len(subnets), prefix+newbits, zones)
+	}
+	return subnets, nil
+}
+
+func overlapsAny(subnet *net.IPNet, networks []*net.IPNet) bool {
+	for _, n := range networks {
+		if n.Contains(subnet.IP) || subnet.Contains(n.IP) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRSubnets(t *testing.T) {
+	subnets, err := CIDRSubnets("10.10.0.0/16", 3, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.10.0.0/18", "10.10.64.0/18", "10.10.128.0/18"}, subnets)
+
+	subnets, err = CIDRSubnets("166.0.0.0/8", 2, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"166.0.0.0/14", "166.4.0.0/14"}, subnets)
+
+	// 166.8.0.0/14 is reserved for the cloud service endpoints.
+	subnets, err = CIDRSubnets("166.0.0.0/8", 3, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"166.0.0.0/14", "166.4.0.0/14", "166.12.0.0/14"}, subnets)
+}
+
+func TestCIDRSubnetsErrors(t *testing.T) {
+	_, err := CIDRSubnets("10.10.0.0", 3, 2)
+	assert.Error(t, err)
+
+	_, err = CIDRSubnets("fd00::/64", 3, 2)
+	assert.Error(t, err)
+
+	_, err = CIDRSubnets("10.10.0.0/16", 0, 2)
+	assert.Error(t, err)
+
+	_, err = CIDRSubnets("10.10.0.0/30", 3, 4)
+	assert.Error(t, err)
+
+	_, err = CIDRSubnets("10.10.0.0/16", 5, 2)
+	assert.Error(t, err)
+
+	_, err = CIDRSubnets("169.254.0.0/16", 1, 2)
+	assert.Error(t, err)
+}
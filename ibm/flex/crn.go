@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns the CRN in its crn:v1:... form, the reverse of Parse.
+func (c CRN) String() string {
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		c.Scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
+// BuildCRN returns the CRN of a resource of a public IBM Cloud service owned
+// by account. region is "" or "global" for the global services, and
+// resourceType and resource are "" for a service instance, e.g.
+//
+//	BuildCRN("is", "us-south", "1234", "vpc", "r006-...")
+//	// crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-...
+func BuildCRN(service, region, account, resourceType, resource string) (string, error) {
+	if service == "" {
+		return "", fmt.Errorf("[ERROR] The service of a CRN can't be empty")
+	}
+	if account == "" {
+		return "", fmt.Errorf("[ERROR] The account of a CRN can't be empty")
+	}
+	if resource != "" && resourceType == "" {
+		return "", fmt.Errorf("[ERROR] The resource type of a CRN is required with its resource")
+	}
+	for name, value := range map[string]string{"service": service, "region": region, "account": account, "resource type": resourceType} {
+		if strings.ContainsAny(value, crnSeparator+scopeSeparator) {
+			return "", fmt.Errorf("[ERROR] The %s of a CRN can't contain %q or %q: %q", name, crnSeparator, scopeSeparator, value)
+		}
+	}
+	if strings.Contains(resource, crnSeparator) {
+		return "", fmt.Errorf("[ERROR] The resource of a CRN can't contain %q: %q", crnSeparator, resource)
+	}
+	if region == "global" {
+		region = ""
+	}
+	return CRN{
+		Scheme:       crn,
+		Version:      "v1",
+		CName:        "bluemix",
+		CType:        "public",
+		ServiceName:  service,
+		Region:       region,
+		ScopeType:    "a",
+		Scope:        account,
+		ResourceType: resourceType,
+		Resource:     resource,
+	}.String(), nil
+}
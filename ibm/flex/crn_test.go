@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+)
+
+func TestBuildCRN(t *testing.T) {
+	cases := []struct {
+		service, region, account, resourceType, resource string
+		expected                                         string
+	}{
+		{"is", "us-south", "1234", "vpc", "r006-abcd", "crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-abcd"},
+		{"iam-identity", "global", "1234", "", "", "crn:v1:bluemix:public:iam-identity::a/1234:::"},
+		{"cloud-object-storage", "", "1234", "bucket", "my-bucket", "crn:v1:bluemix:public:cloud-object-storage::a/1234::bucket:my-bucket"},
+	}
+	for _, c := range cases {
+		got, err := BuildCRN(c.service, c.region, c.account, c.resourceType, c.resource)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, got)
+		}
+		parsed, err := Parse(got)
+		if err != nil || parsed.String() != got {
+			t.Errorf("expected %q to parse back, got %q, %v", got, parsed.String(), err)
+		}
+	}
+}
+
+func TestBuildCRNInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"", "us-south", "1234", "vpc", "r006"},
+		{"is", "us-south", "", "vpc", "r006"},
+		{"is", "us-south", "1234", "", "r006"},
+		{"is", "us:south", "1234", "vpc", "r006"},
+		{"is", "us-south", "1234", "vpc", "r006:x"},
+	} {
+		if _, err := BuildCRN(args[0], args[1], args[2], args[3], args[4]); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -53,8 +52,8 @@ func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	if len(d.Id()) == 0 {
 		return false
 	}
-	isSecretRef, _ := regexp.MatchString("[{]{1}(\\b(vault)\\b[:]{2}([ a-zA-Z0-9_-]*)[.]{0,1}(.*))[}]{1}", new)
-	if isSecretRef {
+	// The secret references aren't hashed by the API.
+	if LooksLikeSecretReference(new) || IsSecretReference(new) {
 		return false
 	}
 	parts, _ := SepIdParts(d.Id(), "/")
@@ -68,17 +67,23 @@ func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 func SuppressPipelinePropertyRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	// ResourceIBMCdTektonPipelineProperty
 	if d.Get("type").(string) == "secure" {
-		segs := []string{d.Get("pipeline_id").(string), d.Get("name").(string)}
-		secret := strings.Join(segs, ".")
-		mac := hmac.New(sha3.New512, []byte(secret))
-		mac.Write([]byte(new))
-		secureHmac := hex.EncodeToString(mac.Sum(nil))
-		return cmp.Equal(strings.Join([]string{"hash", "SHA3-512", secureHmac}, ":"), old)
+		return cmp.Equal(HashPipelinePropertySecret(d.Get("pipeline_id").(string), d.Get("name").(string), new), old)
 	} else {
 		return old == new
 	}
 }
 
+// HashPipelinePropertySecret returns the value of a secure pipeline property
+// as it is returned by the API.
+func HashPipelinePropertySecret(pipelineID, name, value string) string {
+	segs := []string{pipelineID, name}
+	secret := strings.Join(segs, ".")
+	mac := hmac.New(sha3.New512, []byte(secret))
+	mac.Write([]byte(value))
+	secureHmac := hex.EncodeToString(mac.Sum(nil))
+	return strings.Join([]string{"hash", "SHA3-512", secureHmac}, ":")
+}
+
 func SuppressTriggerPropertyRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	// ResourceIBMCdTektonPipelineTriggerProperty
 	if d.Get("type").(string) == "secure" {
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+)
+
+// MaxPages bounds the number of pages fetched by GetAllPages, so that a
+// service returning the same start token over and over can't hang a refresh.
+const MaxPages = 10000
+
+// GetAllPages returns the items of every page of a paginated list API. list is
+// called with the start token of the page to fetch ("" for the first page) and
+// returns the items of that page and the start token of the next page, "" for
+// the last page. Use GetNext / GetNextIAM to extract the token from the `next`
+// link of the collection, e.g.
+//
+//	volumes, err := flex.GetAllPages(func(start string) ([]vpcv1.Volume, string, error) {
+//		if start != "" {
+//			listVolumesOptions.Start = &start
+//		}
+//		collection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
+//		if err != nil {
+//			return nil, "", fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response)
+//		}
+//		return collection.Volumes, flex.GetNext(collection.Next), nil
+//	})
+func GetAllPages[T any](list func(start string) ([]T, string, error)) ([]T, error) {
+	all := []T{}
+	start := ""
+	seen := map[string]bool{}
+	for page := 0; page < MaxPages; page++ {
+		items, next, err := list(start)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if next == "" {
+			return all, nil
+		}
+		if seen[next] {
+			return nil, fmt.Errorf("[ERROR] Pagination error: the start token %q was returned twice", next)
+		}
+		seen[next] = true
+		start = next
+	}
+	return nil, fmt.Errorf("[ERROR] Pagination error: more than %d pages", MaxPages)
+}
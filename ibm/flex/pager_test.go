@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllPages(t *testing.T) {
+	pages := map[string][]int{
+		"":  {1, 2},
+		"b": {3, 4},
+		"c": {5},
+	}
+	next := map[string]string{"": "b", "b": "c", "c": ""}
+
+	var starts []string
+	items, err := GetAllPages(func(start string) ([]int, string, error) {
+		starts = append(starts, start)
+		return pages[start], next[start], nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, []string{"", "b", "c"}, starts)
+}
+
+func TestGetAllPagesEmpty(t *testing.T) {
+	items, err := GetAllPages(func(start string) ([]string, string, error) {
+		return nil, "", nil
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, items)
+	assert.Empty(t, items)
+}
+
+func TestGetAllPagesErrors(t *testing.T) {
+	listErr := errors.New("list failed")
+	_, err := GetAllPages(func(start string) ([]int, string, error) {
+		if start == "b" {
+			return nil, "", listErr
+		}
+		return []int{1}, "b", nil
+	})
+	assert.ErrorIs(t, err, listErr)
+
+	_, err = GetAllPages(func(start string) ([]int, string, error) {
+		return []int{1}, "same", nil
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Quotas of the account limiting the number of resources.
+const (
+	QuotaServiceInstances       = "service instances"
+	QuotaVirtualServerInstances = "virtual server instances"
+)
+
+// accountQuotas maps the resource types to the quota of the account limiting
+// their number.
+var accountQuotas = map[string]string{
+	"ibm_resource_instance": QuotaServiceInstances,
+	"ibm_database":          QuotaServiceInstances,
+	"ibm_is_instance":       QuotaVirtualServerInstances,
+}
+
+// QuotaUsage is the usage and the limit of a quota of the account.
+type QuotaUsage struct {
+	// Name is the quota, e.g. QuotaServiceInstances.
+	Name string
+	// Definition is the name of the quota definition of the account, e.g.
+	// "Pay-As-You-Go Quota".
+	Definition string
+	Usage      int64
+	Limit      int64
+}
+
+func (q *QuotaUsage) String() string {
+	return fmt.Sprintf("The %s quota of the account (%s) is %d of %d used", q.Name, q.Definition, q.Usage, q.Limit)
+}
+
+// QuotaLookup returns the usage and the limit of the quota of the account
+// limiting the resources of resourceType, nil if they aren't limited by a
+// known quota.
+type QuotaLookup func(resourceType string) (*QuotaUsage, error)
+
+// lookupQuota returns the quota limiting the resources of resourceType, e.g.
+// "ibm_is_instance" or "(Data) ibm_is_instance", nil if it isn't known or
+// can't be fetched.
+func lookupQuota(lookup QuotaLookup, resourceType string) *QuotaUsage {
+	if lookup == nil {
+		return nil
+	}
+
+	resourceType = strings.TrimSpace(strings.TrimPrefix(resourceType, "(Data)"))
+	usage, err := lookup(resourceType)
+	if err != nil {
+		log.Printf("[WARN] The quota limiting %s can't be fetched: %s", resourceType, err)
+		return nil
+	}
+	return usage
+}
+
+// SDKQuotaErrorf is SDKErrorf for the operations creating resources that
+// count against a quota of the account. The quota errors are completed with
+// the usage and the limit of the quota that was reached, fetched with the
+// client session in meta.
+func SDKQuotaErrorf(err error, response *core.DetailedResponse, summary, resource, operation string, meta interface{}) *TerraformProblem {
+	var lookup QuotaLookup
+	if sess, ok := meta.(conns.ClientSession); ok {
+		lookup = AccountQuotaLookup(sess)
+	}
+	return sdkErrorf(err, response, summary, resource, operation, lookup)
+}
+
+// AccountQuotaLookup returns a QuotaLookup fetching the limits from the quota
+// definition of the account, and the usage from the Resource Controller and
+// VPC APIs.
+func AccountQuotaLookup(sess conns.ClientSession) QuotaLookup {
+	return func(resourceType string) (*QuotaUsage, error) {
+		quota, ok := accountQuotas[resourceType]
+		if !ok {
+			return nil, nil
+		}
+		userDetails, err := sess.BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		definition, err := accountQuotaDefinition(sess, userDetails.UserAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := &QuotaUsage{Name: quota, Definition: definition.Name}
+		switch quota {
+		case QuotaServiceInstances:
+			usage.Limit = int64(definition.ServiceInstanceCountLimit)
+			usage.Usage, err = countServiceInstances(sess)
+		case QuotaVirtualServerInstances:
+			usage.Limit = int64(definition.VSICountLimit)
+			usage.Usage, err = countVirtualServerInstances(sess)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return usage, nil
+	}
+}
+
+// accountQuotaDefinition returns the quota definition of the account, which is
+// the one of its default resource group.
+func accountQuotaDefinition(sess conns.ClientSession, accountID string) (*accountQuota, error) {
+	rMgtClient, err := sess.ResourceManagerV2API()
+	if err != nil {
+		return nil, err
+	}
+	groups, response, err := rMgtClient.ListResourceGroups(&rg.ListResourceGroupsOptions{
+		AccountID: &accountID,
+		Default:   PtrToBool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListResourceGroups failed: %s %s", err, response)
+	}
+	if groups == nil || len(groups.Resources) == 0 || groups.Resources[0].QuotaID == nil {
+		return nil, fmt.Errorf("the default resource group of the account %s wasn't found", accountID)
+	}
+
+	rsManagementAPI, err := sess.ResourceManagementAPIv2()
+	if err != nil {
+		return nil, err
+	}
+	definition, err := rsManagementAPI.ResourceQuota().Get(*groups.Resources[0].QuotaID)
+	if err != nil {
+		return nil, err
+	}
+	return &accountQuota{
+		Name:                      definition.Name,
+		ServiceInstanceCountLimit: int(definition.ServiceInstanceCountLimit),
+		VSICountLimit:             int(definition.VSICountLimit),
+	}, nil
+}
+
+type accountQuota struct {
+	Name                      string
+	ServiceInstanceCountLimit int
+	VSICountLimit             int
+}
+
+func countServiceInstances(sess conns.ClientSession) (int64, error) {
+	rsConClient, err := sess.ResourceControllerV2API()
+	if err != nil {
+		return 0, err
+	}
+	listOptions := &rc.ListResourceInstancesOptions{
+		Type: core.StringPtr("service_instance"),
+	}
+	instances, err := GetAllPages(func(start string) ([]rc.ResourceInstance, string, error) {
+		if start != "" {
+			listOptions.Start = &start
+		}
+		list, response, err := rsConClient.ListResourceInstances(listOptions)
+		if err != nil || list == nil {
+			return nil, "", fmt.Errorf("ListResourceInstances failed: %s %s", err, response)
+		}
+		return list.Resources, nextURLStart(list.NextURL), nil
+	})
+	return int64(len(instances)), err
+}
+
+// countVirtualServerInstances returns the number of virtual server instances
+// of the account. The VPC API is regional while the quota applies to the
+// whole account, so the instances of every region are counted.
+func countVirtualServerInstances(sess conns.ClientSession) (int64, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return 0, err
+	}
+	regions, response, err := vpcClient.ListRegions(&vpcv1.ListRegionsOptions{})
+	if err != nil || regions == nil {
+		return 0, fmt.Errorf("ListRegions failed: %s %s", err, response)
+	}
+
+	var count int64
+	for _, region := range regions.Regions {
+		if region.Name == nil || region.Endpoint == nil || region.Status == nil || *region.Status != "available" {
+			continue
+		}
+		regionClient := vpcClient.Clone()
+		if err := regionClient.SetServiceURL(strings.TrimSuffix(*region.Endpoint, "/") + "/v1"); err != nil {
+			return 0, err
+		}
+		instances, response, err := regionClient.ListInstances(&vpcv1.ListInstancesOptions{
+			Limit: core.Int64Ptr(1),
+		})
+		if err != nil || instances == nil || instances.TotalCount == nil {
+			return 0, fmt.Errorf("ListInstances failed in %s: %s %s", *region.Name, err, response)
+		}
+		count += *instances.TotalCount
+	}
+	return count, nil
+}
+
+// nextURLStart returns the start token of the next_url of a Resource
+// Controller collection, "" for the last page.
+func nextURLStart(next *string) string {
+	if next == nil {
+		return ""
+	}
+	u, err := url.Parse(*next)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("next_url")
+}
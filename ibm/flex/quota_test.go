@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSDKErrorfQuota(t *testing.T) {
+	var lookedUp string
+	lookup := func(resourceType string) (*QuotaUsage, error) {
+		lookedUp = resourceType
+		if resourceType != "ibm_is_instance" {
+			return nil, errors.New("unknown quota")
+		}
+		return &QuotaUsage{Name: QuotaVirtualServerInstances, Definition: "Pay-As-You-Go Quota", Usage: 25, Limit: 25}, nil
+	}
+
+	response := &core.DetailedResponse{
+		StatusCode: 400,
+		Result:     map[string]interface{}{"code": "over_quota"},
+	}
+	terraformProb := sdkErrorf(errors.New("Quota exceeded"), response, "CreateInstanceWithContext failed", "ibm_is_instance", "create", lookup)
+	assert.Equal(t, "ibm_is_instance", lookedUp)
+	assert.Contains(t, terraformProb.Summary, "[quota, code: over_quota]. The virtual server instances quota of the account (Pay-As-You-Go Quota) is 25 of 25 used. A quota")
+
+	terraformProb = sdkErrorf(errors.New("Quota exceeded"), response, "CreateResourceInstanceWithContext failed", "(Data) ibm_resource_instance", "read", lookup)
+	assert.Equal(t, "ibm_resource_instance", lookedUp)
+	assert.Contains(t, terraformProb.Summary, "[quota, code: over_quota]. A quota")
+
+	lookedUp = ""
+	sdkErrorf(errors.New("Not found"), &core.DetailedResponse{StatusCode: 404}, "GetInstanceWithContext failed", "ibm_is_instance", "read", lookup)
+	assert.Empty(t, lookedUp)
+
+	// Without a client session the quota isn't looked up.
+	terraformProb = SDKQuotaErrorf(errors.New("Quota exceeded"), response, "CreateInstanceWithContext failed", "ibm_is_instance", "create", nil)
+	assert.Contains(t, terraformProb.Summary, "[quota, code: over_quota]. A quota")
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceGroupKeys are the names used by the resources for the ID of their
+// resource group.
+var resourceGroupKeys = []string{"resource_group_id", "resource_group"}
+
+// ResourceGroupKey returns the name of the resource group argument of a
+// resource which can default to the provider resource_group_id, or "" if the
+// resource doesn't have one. Only optional and computed arguments qualify, as
+// their value is reported by the API when it isn't configured.
+func ResourceGroupKey(resourceSchema map[string]*schema.Schema) string {
+	for _, key := range resourceGroupKeys {
+		if s, ok := resourceSchema[key]; ok && s.Type == schema.TypeString && s.Optional && s.Computed {
+			return key
+		}
+	}
+	return ""
+}
+
+// ResourceGroupCustomizeDiff plans the provider resource_group_id as the
+// resource group of a new resource which doesn't configure one.
+func ResourceGroupCustomizeDiff(diff *schema.ResourceDiff, meta interface{}, key string) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	session, ok := meta.(conns.ClientSession)
+	if !ok || session.DefaultResourceGroupID() == "" {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(key) || !config.GetAttr(key).IsNull() {
+		return nil
+	}
+	return diff.SetNew(key, session.DefaultResourceGroupID())
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGroupKey(t *testing.T) {
+	assert.Equal(t, "resource_group_id", ResourceGroupKey(map[string]*schema.Schema{
+		"resource_group_id": {Type: schema.TypeString, Optional: true, Computed: true, ForceNew: true},
+	}))
+	assert.Equal(t, "resource_group", ResourceGroupKey(map[string]*schema.Schema{
+		"resource_group": {Type: schema.TypeString, Optional: true, Computed: true},
+	}))
+	assert.Empty(t, ResourceGroupKey(map[string]*schema.Schema{
+		"resource_group_id": {Type: schema.TypeString, Required: true},
+	}))
+	assert.Empty(t, ResourceGroupKey(map[string]*schema.Schema{
+		"resource_group_id": {Type: schema.TypeString, Computed: true},
+	}))
+	assert.Empty(t, ResourceGroupKey(map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+	}))
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Classes of the errors returned by the IBM Cloud APIs.
+const (
+	ErrorClassAuth     = "auth"
+	ErrorClassQuota    = "quota"
+	ErrorClassNotFound = "not-found"
+	ErrorClassConflict = "conflict"
+)
+
+var errorClassHints = map[string]string{
+	ErrorClassAuth: "Check that the API key or token is valid and that the user, service ID or trusted profile " +
+		"has the IAM role required by this operation on the target service instance or resource group. " +
+		"See https://cloud.ibm.com/docs/account?topic=account-assign-access-resources",
+	ErrorClassQuota: "A quota or a rate limit of the account was reached. Retry later with a lower -parallelism, " +
+		"or release unused resources. See https://cloud.ibm.com/docs/account?topic=account-resource-quotas",
+	ErrorClassNotFound: "The resource doesn't exist or is not visible with the configured region, account and credentials. " +
+		"If it was deleted outside of Terraform, remove it from the state with `terraform state rm`",
+	ErrorClassConflict: "The resource is being changed by another operation or already exists. " +
+		"Wait for the pending operation to complete and retry, or import the existing resource with `terraform import`",
+}
+
+// ClassifyError returns the class (auth, quota, not-found or conflict) and the
+// IBM error codes of an error returned by an SDK operation. The class is empty
+// when the error doesn't fall in one of the known classes.
+func ClassifyError(err error, response *core.DetailedResponse) (class string, codes []string) {
+	if response == nil {
+		var httpProblem *core.HTTPProblem
+		if errors.As(err, &httpProblem) {
+			response = httpProblem.Response
+		}
+	}
+	if response == nil {
+		return "", nil
+	}
+
+	codes = errorCodes(response)
+	switch response.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		class = ErrorClassAuth
+	case http.StatusTooManyRequests:
+		class = ErrorClassQuota
+	case http.StatusNotFound:
+		class = ErrorClassNotFound
+	case http.StatusConflict:
+		class = ErrorClassConflict
+	default:
+		for _, code := range codes {
+			code = strings.ToLower(code)
+			if strings.Contains(code, "quota") || strings.Contains(code, "limit_exceeded") {
+				class = ErrorClassQuota
+			}
+		}
+	}
+	return class, codes
+}
+
+// SDKErrorf creates a TerraformProblem for the error of an SDK operation. The
+// summary is completed with the class of the error, the IBM error codes of the
+// response and a remediation hint, e.g.
+//
+//	GetToolByIDWithContext failed: <error> [not-found, code: tool_not_found]. The resource doesn't exist...
+func SDKErrorf(err error, response *core.DetailedResponse, summary, resource, operation string) *TerraformProblem {
+	return sdkErrorf(err, response, summary, resource, operation, nil)
+}
+
+func sdkErrorf(err error, response *core.DetailedResponse, summary, resource, operation string, lookup QuotaLookup) *TerraformProblem {
+	class, codes := ClassifyError(err, response)
+
+	var b strings.Builder
+	b.WriteString(summary)
+	if err != nil {
+		fmt.Fprintf(&b, ": %s", err)
+	}
+	if class != "" || len(codes) > 0 {
+		details := []string{}
+		if class != "" {
+			details = append(details, class)
+		}
+		if len(codes) > 0 {
+			details = append(details, "code: "+strings.Join(codes, ", "))
+		}
+		fmt.Fprintf(&b, " [%s]", strings.Join(details, ", "))
+	}
+	if class == ErrorClassQuota {
+		if usage := lookupQuota(lookup, resource); usage != nil {
+			fmt.Fprintf(&b, ". %s", usage)
+		}
+	}
+	if hint, ok := errorClassHints[class]; ok {
+		fmt.Fprintf(&b, ". %s", hint)
+	}
+
+	return DiscriminatedTerraformErrorf(err, b.String(), resource, operation, class)
+}
+
+// errorCodes returns the error codes found in the body of an error response.
+// The IBM Cloud APIs use either the `errors[].code` array of the API handbook,
+// or a single `code` / `errorCode` (IAM) field.
+func errorCodes(response *core.DetailedResponse) []string {
+	result, ok := response.GetResultAsMap()
+	if !ok {
+		return nil
+	}
+	var codes []string
+	if errs, ok := result["errors"].([]interface{}); ok {
+		for _, e := range errs {
+			if e, ok := e.(map[string]interface{}); ok {
+				if code, ok := e["code"].(string); ok && code != "" {
+					codes = append(codes, code)
+				}
+			}
+		}
+	}
+	for _, key := range []string{"errorCode", "code"} {
+		if code, ok := result[key].(string); ok && code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	err := errors.New("request failed")
+
+	class, codes := ClassifyError(err, &core.DetailedResponse{
+		StatusCode: 404,
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"code": "tool_not_found", "message": "Tool not found"},
+			},
+		},
+	})
+	assert.Equal(t, ErrorClassNotFound, class)
+	assert.Equal(t, []string{"tool_not_found"}, codes)
+
+	class, codes = ClassifyError(err, &core.DetailedResponse{
+		StatusCode: 403,
+		Result:     map[string]interface{}{"errorCode": "BXNIM0513E"},
+	})
+	assert.Equal(t, ErrorClassAuth, class)
+	assert.Equal(t, []string{"BXNIM0513E"}, codes)
+
+	class, _ = ClassifyError(err, &core.DetailedResponse{
+		StatusCode: 400,
+		Result:     map[string]interface{}{"code": "over_quota"},
+	})
+	assert.Equal(t, ErrorClassQuota, class)
+
+	class, _ = ClassifyError(err, &core.DetailedResponse{StatusCode: 409})
+	assert.Equal(t, ErrorClassConflict, class)
+
+	class, codes = ClassifyError(err, nil)
+	assert.Empty(t, class)
+	assert.Empty(t, codes)
+}
+
+func TestSDKErrorf(t *testing.T) {
+	err := errors.New("Forbidden")
+	response := &core.DetailedResponse{
+		StatusCode: 403,
+		Result: map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{"code": "forbidden"},
+			},
+		},
+	}
+
+	terraformProb := SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_some_resource", "create")
+	assert.True(t, strings.HasPrefix(terraformProb.Summary, "CreateToolWithContext failed: Forbidden [auth, code: forbidden]. "))
+	assert.Contains(t, terraformProb.Summary, "IAM role")
+	assert.Equal(t, "ibm_some_resource", terraformProb.Resource)
+	assert.Equal(t, "create", terraformProb.Operation)
+	assert.NotEqual(t, TerraformErrorf(err, terraformProb.Summary, "ibm_some_resource", "create").GetID(), terraformProb.GetID())
+
+	terraformProb = SDKErrorf(err, nil, "CreateToolWithContext failed", "ibm_some_resource", "create")
+	assert.Equal(t, "CreateToolWithContext failed: Forbidden", terraformProb.Summary)
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// {vault::<integration name>.<secret path>}, where the secret path is
+	// <secret group>.<secret name> for Secrets Manager and <key name> for
+	// Key Protect.
+	secretReferenceRegexp = regexp.MustCompile(`^\{vault::[ a-zA-Z0-9_-]+\.[^{}]+\}$`)
+	// CRN of a Secrets Manager secret or of a Key Protect or HPCS key.
+	secretCRNReferenceRegexp = regexp.MustCompile(`^crn:v1:[^:]+:[^:]+:(secrets-manager|kms|hs-crypto):[^:]*:[^:]*:[^:]+:(secret|key):[^:]+$`)
+	anySecretReferenceRegexp = regexp.MustCompile("[{]{1}(\\b(vault)\\b[:]{2}([ a-zA-Z0-9_-]*)[.]{0,1}(.*))[}]{1}")
+)
+
+// SecretReferenceFormat describes the secret references in error messages.
+const SecretReferenceFormat = "{vault::<integration name>.<secret group>.<secret name>} for Secrets Manager, {vault::<integration name>.<key name>} for Key Protect, or the CRN of the secret"
+
+// IsSecretReference returns whether value is a toolchain secret reference,
+// which the API stores as is instead of hashing it.
+func IsSecretReference(value string) bool {
+	return secretReferenceRegexp.MatchString(value) || secretCRNReferenceRegexp.MatchString(value)
+}
+
+// LooksLikeSecretReference returns whether value is meant to be a secret
+// reference, well-formed or not.
+func LooksLikeSecretReference(value string) bool {
+	return strings.HasPrefix(value, "{vault") || anySecretReferenceRegexp.MatchString(value)
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+)
+
+func TestIsSecretReference(t *testing.T) {
+	cases := []struct {
+		value     string
+		reference bool
+		looksLike bool
+	}{
+		{"{vault::sm-instance.Default.apikey}", true, true},
+		{"{vault::kp_compliance.my-key}", true, true},
+		{"crn:v1:bluemix:public:secrets-manager:us-south:a/1234:5678:secret:9abc", true, false},
+		{"crn:v1:bluemix:public:kms:us-south:a/1234:5678:key:9abc", true, false},
+		{"crn:v1:bluemix:public:is:us-south:a/1234:5678:instance:9abc", false, false},
+		{"{vault::sm-instance}", false, true},
+		{"{vault:sm-instance.Default.apikey}", false, true},
+		{"plaintext", false, false},
+		{"", false, false},
+	}
+	for _, c := range cases {
+		if got := IsSecretReference(c.value); got != c.reference {
+			t.Errorf("IsSecretReference(%q): expected %t, got %t", c.value, c.reference, got)
+		}
+		if got := LooksLikeSecretReference(c.value); got != c.looksLike {
+			t.Errorf("LooksLikeSecretReference(%q): expected %t, got %t", c.value, c.looksLike, got)
+		}
+	}
+}
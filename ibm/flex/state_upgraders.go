@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RenamedAttributes is a schema version bump of a resource renaming the
+// attributes of a nested block. Block is the dot separated path of the block,
+// e.g. "parameters", or "" for top level attributes, and Renames maps the old
+// attribute names to the new ones.
+type RenamedAttributes struct {
+	Block   string
+	Renames map[string]string
+}
+
+// WithStateUpgrades bumps the schema version of resource once for each of the
+// given upgrades, the first one upgrading the state of the current schema
+// version, and appends the state upgraders migrating the existing states to the
+// ones the resource already has, so that changing the layout of a nested block
+// doesn't force the resources to be replaced. It returns resource.
+//
+// The schemas of the previous versions are derived from the current one, so a
+// resource only lists what changed:
+//
+//	return flex.WithStateUpgrades(&schema.Resource{...},
+//		flex.RenamedAttributes{Block: "parameters", Renames: map[string]string{"resource_group": "resource_group_name"}},
+//	)
+func WithStateUpgrades(resource *schema.Resource, upgrades ...RenamedAttributes) *schema.Resource {
+	baseVersion := resource.SchemaVersion
+	upgraders := make([]schema.StateUpgrader, len(upgrades))
+
+	prior := *resource
+	for version := len(upgrades) - 1; version >= 0; version-- {
+		prior.Schema = upgrades[version].downgradeSchema(prior.Schema)
+		upgraders[version] = schema.StateUpgrader{
+			Version: baseVersion + version,
+			Type:    prior.CoreConfigSchema().ImpliedType(),
+			Upgrade: upgrades[version].upgradeState,
+		}
+	}
+	resource.SchemaVersion = baseVersion + len(upgrades)
+	resource.StateUpgraders = append(resource.StateUpgraders, upgraders...)
+	return resource
+}
+
+func (u RenamedAttributes) upgradeState(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	for _, block := range nestedStateBlocks(rawState, splitBlockPath(u.Block)) {
+		for from, to := range u.Renames {
+			value, ok := block[from]
+			if !ok {
+				continue
+			}
+			if current, exists := block[to]; !exists || current == nil {
+				block[to] = value
+			}
+			delete(block, from)
+		}
+	}
+	return rawState, nil
+}
+
+// downgradeSchema returns a copy of schemaMap with the renamed attributes under
+// their old names.
+func (u RenamedAttributes) downgradeSchema(schemaMap map[string]*schema.Schema) map[string]*schema.Schema {
+	return renameSchemaAttributes(schemaMap, splitBlockPath(u.Block), u.Renames)
+}
+
+func renameSchemaAttributes(schemaMap map[string]*schema.Schema, path []string, renames map[string]string) map[string]*schema.Schema {
+	renamed := make(map[string]*schema.Schema, len(schemaMap))
+	for name, attribute := range schemaMap {
+		renamed[name] = attribute
+	}
+
+	if len(path) == 0 {
+		for from, to := range renames {
+			if attribute, ok := renamed[to]; ok {
+				delete(renamed, to)
+				renamed[from] = attribute
+			}
+		}
+		return renamed
+	}
+
+	block, ok := renamed[path[0]]
+	if !ok {
+		return renamed
+	}
+	elem, ok := block.Elem.(*schema.Resource)
+	if !ok {
+		return renamed
+	}
+	blockCopy := *block
+	elemCopy := *elem
+	elemCopy.Schema = renameSchemaAttributes(elem.Schema, path[1:], renames)
+	blockCopy.Elem = &elemCopy
+	renamed[path[0]] = &blockCopy
+	return renamed
+}
+
+// nestedStateBlocks returns the objects of the raw state at path, walking
+// through every element of the nested lists and sets.
+func nestedStateBlocks(rawState map[string]interface{}, path []string) []map[string]interface{} {
+	if rawState == nil {
+		return nil
+	}
+	if len(path) == 0 {
+		return []map[string]interface{}{rawState}
+	}
+
+	var blocks []map[string]interface{}
+	switch value := rawState[path[0]].(type) {
+	case map[string]interface{}:
+		blocks = append(blocks, nestedStateBlocks(value, path[1:])...)
+	case []interface{}:
+		for _, element := range value {
+			if object, ok := element.(map[string]interface{}); ok {
+				blocks = append(blocks, nestedStateBlocks(object, path[1:])...)
+			}
+		}
+	}
+	return blocks
+}
+
+func splitBlockPath(block string) []string {
+	if block == "" {
+		return nil
+	}
+	return strings.Split(block, ".")
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testStateUpgradesResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWithStateUpgrades(t *testing.T) {
+	resource := WithStateUpgrades(testStateUpgradesResource(),
+		RenamedAttributes{Block: "parameters", Renames: map[string]string{"resource_group": "resource_group_name"}},
+		RenamedAttributes{Renames: map[string]string{"title": "name"}},
+	)
+	if resource.SchemaVersion != 2 || len(resource.StateUpgraders) != 2 {
+		t.Fatalf("expected schema version 2 with 2 state upgraders, got %d and %d", resource.SchemaVersion, len(resource.StateUpgraders))
+	}
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v0 := resource.StateUpgraders[0].Type
+	if !v0.HasAttribute("title") || !v0.AttributeType("parameters").ElementType().HasAttribute("resource_group") {
+		t.Errorf("expected the version 0 schema to have the old attribute names, got %#v", v0)
+	}
+	v1 := resource.StateUpgraders[1].Type
+	if !v1.HasAttribute("title") || !v1.AttributeType("parameters").ElementType().HasAttribute("resource_group_name") {
+		t.Errorf("expected the version 1 schema to have the renamed nested attribute, got %#v", v1)
+	}
+	if _, ok := resource.Schema["parameters"].Elem.(*schema.Resource).Schema["resource_group_name"]; !ok {
+		t.Errorf("expected the current schema not to be modified")
+	}
+
+	state := map[string]interface{}{
+		"title": "tool",
+		"parameters": []interface{}{
+			map[string]interface{}{"resource_group": "default"},
+		},
+	}
+	for _, upgrader := range resource.StateUpgraders {
+		var err error
+		if state, err = upgrader.Upgrade(context.Background(), state, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	expected := map[string]interface{}{
+		"name": "tool",
+		"parameters": []interface{}{
+			map[string]interface{}{"resource_group_name": "default"},
+		},
+	}
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("expected the upgraded state %v, got %v", expected, state)
+	}
+}
+
+func TestWithStateUpgradesAppendsToExistingUpgraders(t *testing.T) {
+	existing := schema.StateUpgrader{
+		Version: 0,
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			rawState["title"] = rawState["label"]
+			delete(rawState, "label")
+			return rawState, nil
+		},
+	}
+	resource := testStateUpgradesResource()
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{existing}
+
+	resource = WithStateUpgrades(resource,
+		RenamedAttributes{Renames: map[string]string{"title": "name"}},
+	)
+	if resource.SchemaVersion != 2 || len(resource.StateUpgraders) != 2 {
+		t.Fatalf("expected schema version 2 with 2 state upgraders, got %d and %d", resource.SchemaVersion, len(resource.StateUpgraders))
+	}
+	if resource.StateUpgraders[0].Version != 0 || resource.StateUpgraders[1].Version != 1 {
+		t.Fatalf("expected the state upgraders of versions 0 and 1, got %d and %d", resource.StateUpgraders[0].Version, resource.StateUpgraders[1].Version)
+	}
+	if !resource.StateUpgraders[1].Type.HasAttribute("title") {
+		t.Errorf("expected the version 1 schema to have the old attribute name, got %#v", resource.StateUpgraders[1].Type)
+	}
+
+	state := map[string]interface{}{"label": "tool"}
+	for _, upgrader := range resource.StateUpgraders {
+		var err error
+		if state, err = upgrader.Upgrade(context.Background(), state, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if expected := map[string]interface{}{"name": "tool"}; !reflect.DeepEqual(state, expected) {
+		t.Errorf("expected the upgraded state %v, got %v", expected, state)
+	}
+}
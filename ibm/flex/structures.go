@@ -12,7 +12,6 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
-	"os"
 	"path"
 	"reflect"
 	"strconv"
@@ -1745,6 +1744,33 @@ func FlattenRuleConditions(rule iampolicymanagementv1.V2PolicyRule) []map[string
 	return result
 }
 
+// SetV2PolicyRule sets the rule_conditions, rule_operator and pattern of an
+// access policy resource from the policy, so that the conditions of an
+// imported policy, or changed outside of Terraform, aren't dropped.
+func SetV2PolicyRule(d *schema.ResourceData, policy iampolicymanagementv1.V2PolicyTemplateMetaData) error {
+	rule, ok := policy.Rule.(*iampolicymanagementv1.V2PolicyRule)
+	if !ok || rule == nil {
+		if err := d.Set("rule_conditions", []map[string]interface{}{}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting rule_conditions: %s", err)
+		}
+		d.Set("rule_operator", "")
+		if _, ok := d.GetOk("pattern"); ok {
+			d.Set("pattern", StringValue(policy.Pattern))
+		}
+		return nil
+	}
+	if err := d.Set("rule_conditions", FlattenRuleConditions(*rule)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rule_conditions: %s", err)
+	}
+	// The operator of a rule with a single condition is the one of the
+	// condition, rule_operator only applies to several conditions
+	if len(rule.Conditions) > 0 {
+		d.Set("rule_operator", StringValue(rule.Operator))
+	}
+	d.Set("pattern", StringValue(policy.Pattern))
+	return nil
+}
+
 // Cloud Internet Services
 func FlattenHealthMonitors(list []datatypes.Network_LBaaS_Listener) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
@@ -2513,12 +2539,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	}
 
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
-		schematicTags := os.Getenv("IC_ENV_TAGS")
-		var envTags []string
-		if schematicTags != "" {
-			envTags = strings.Split(schematicTags, ",")
-			add = append(add, envTags...)
-		}
+		add, remove = mergeEnvTagChanges(news, add, remove, GetEnvTags(meta))
 	}
 
 	if len(remove) > 0 {
@@ -2647,12 +2668,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		remove[i] = fmt.Sprint(v)
 	}
 
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
-	}
+	add, remove = mergeEnvTagChanges(news, add, remove, GetEnvTags(meta))
 
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
@@ -2696,7 +2712,7 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2704,13 +2720,19 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 		newSet := n.(*schema.Set)
 		removeInt := oldSet.Difference(newSet).List()
 		addInt := newSet.Difference(oldSet).List()
-		if v := os.Getenv("IC_ENV_TAGS"); v != "" {
-			s := strings.Split(v, ",")
-			if len(removeInt) == len(s) && len(addInt) == 0 {
-				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
+		envTags := GetEnvTags(meta)
+		if len(addInt) > 0 || len(envTags) == 0 {
+			return nil
+		}
+		// Only suppress the diff when every removed tag is an env/default tag,
+		// otherwise the user explicitly dropped a tag of their own.
+		for _, v := range removeInt {
+			if !containsTag(envTags, fmt.Sprint(v)) {
+				return nil
 			}
 		}
+		log.Println("[DEBUG] Suppressing the diff of env and default tags")
+		return diff.Clear("tags")
 	}
 	return nil
 }
@@ -3043,6 +3065,9 @@ func GetNextIAM(next interface{}) string {
 
 /* Return the default resource group */
 func DefaultResourceGroup(meta interface{}) (string, error) {
+	if id := meta.(conns.ClientSession).DefaultResourceGroupID(); id != "" {
+		return id, nil
+	}
 
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
@@ -4048,7 +4073,9 @@ func generatePolicyRuleCondition(c map[string]interface{}) iampolicymanagementv1
 		values[i] = fmt.Sprint(v)
 	}
 
-	if len(values) > 1 {
+	if len(values) == 0 {
+		return r
+	} else if len(values) > 1 {
 		r.Value = &values
 	} else if operator == "stringExists" && values[0] == "true" {
 		r.Value = true
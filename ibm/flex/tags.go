@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetEnvTags returns the tags attached to every taggable resource: the tags of
+// the IC_ENV_TAGS environment variable followed by the default_tags of the
+// provider configuration in meta.
+func GetEnvTags(meta interface{}) []string {
+	var tags []string
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		tags = append(tags, strings.Split(v, ",")...)
+	}
+	if session, ok := meta.(conns.ClientSession); ok {
+		tags = append(tags, session.DefaultTags()...)
+	}
+	return uniqueTags(tags)
+}
+
+// HasTags reports whether the tags of the resource need to be attached on
+// create, that is whether the resource sets tags or env tags are configured.
+func HasTags(d *schema.ResourceData, key string, meta interface{}) bool {
+	if _, ok := d.GetOk(key); ok {
+		return true
+	}
+	return len(GetEnvTags(meta)) > 0
+}
+
+// MergeEnvTags returns the resource tags together with the env tags. A tag set
+// on the resource takes precedence over an env tag with the same key, so that
+// `env:dev` on a resource is not attached next to a default `env:prod`.
+func MergeEnvTags(tags []string, meta interface{}) []string {
+	return mergeTags(tags, GetEnvTags(meta))
+}
+
+// IsEnvTag reports whether the tag is one of the env tags.
+func IsEnvTag(tag string, meta interface{}) bool {
+	return containsTag(GetEnvTags(meta), tag)
+}
+
+// mergeEnvTagChanges adds the env tags that are not overridden by a resource
+// tag to the tags to attach, and keeps them from being detached. An env tag
+// overridden by a resource tag with the same key is detached.
+func mergeEnvTagChanges(news *schema.Set, add, remove []string, envTags []string) ([]string, []string) {
+	tags := make([]string, 0, news.Len())
+	for _, v := range news.List() {
+		tags = append(tags, fmt.Sprint(v))
+	}
+	merged := mergeTags(tags, envTags)
+	for _, tag := range merged {
+		if !news.Contains(tag) {
+			add = append(add, tag)
+		}
+	}
+	filtered := make([]string, 0, len(remove))
+	for _, tag := range remove {
+		if !containsTag(merged, tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return uniqueTags(add), filtered
+}
+
+func mergeTags(tags, envTags []string) []string {
+	merged := append([]string{}, tags...)
+	keys := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		keys[tagKey(tag)] = true
+	}
+	for _, tag := range envTags {
+		if !keys[tagKey(tag)] {
+			merged = append(merged, tag)
+		}
+	}
+	return uniqueTags(merged)
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(strings.TrimSpace(t), strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+func tagKey(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.Index(tag, ":"); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		unique = append(unique, tag)
+	}
+	return unique
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnvTags(t *testing.T) {
+	t.Setenv("IC_ENV_TAGS", "schematics:workspace, owner:ops")
+
+	assert.Equal(t, []string{"schematics:workspace", "owner:ops"}, GetEnvTags(nil))
+	assert.True(t, IsEnvTag("OWNER:ops", nil))
+	assert.False(t, IsEnvTag("env:dev", nil))
+}
+
+func TestMergeTags(t *testing.T) {
+	envTags := []string{"env:prod", "team:platform"}
+
+	assert.Equal(t, []string{"env:dev", "app", "team:platform"}, mergeTags([]string{"env:dev", "app"}, envTags))
+	assert.Equal(t, []string{"env:prod", "team:platform"}, mergeTags(nil, envTags))
+}
+
+func TestMergeEnvTagChanges(t *testing.T) {
+	envTags := []string{"env:prod", "team:platform"}
+
+	news := schema.NewSet(schema.HashString, []interface{}{"app"})
+	add, remove := mergeEnvTagChanges(news, []string{"app"}, []string{"team:platform", "old"}, envTags)
+
+	assert.Equal(t, []string{"app", "env:prod", "team:platform"}, add)
+	assert.Equal(t, []string{"old"}, remove)
+}
+
+func TestMergeEnvTagChangesOverride(t *testing.T) {
+	envTags := []string{"env:prod", "team:platform"}
+
+	// env:prod was attached before the resource set env:dev, it is detached.
+	news := schema.NewSet(schema.HashString, []interface{}{"env:dev", "team:platform"})
+	add, remove := mergeEnvTagChanges(news, []string{"env:dev"}, []string{"env:prod"}, envTags)
+
+	assert.Equal(t, []string{"env:dev"}, add)
+	assert.Equal(t, []string{"env:prod"}, remove)
+}
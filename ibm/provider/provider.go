@@ -13,7 +13,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -115,6 +117,12 @@ func Provider() *schema.Provider {
 				Description: "The Resource group id.",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_RESOURCE_GROUP", "IBMCLOUD_RESOURCE_GROUP", "BM_RESOURCE_GROUP", "BLUEMIX_RESOURCE_GROUP"}, ""),
 			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the default resource group of the resources which don't set a resource group.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_RESOURCE_GROUP_ID", "IBMCLOUD_RESOURCE_GROUP_ID"}, ""),
+			},
 			"softlayer_api_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -173,6 +181,45 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The base delay (in seconds) between retries of API calls that failed with a rate limit or server error. The delay grows exponentially with jitter on every attempt.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_RETRY_DELAY", "IBMCLOUD_RETRY_DELAY"}, 5),
+			},
+			"retry_max_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum delay (in seconds) between two retries of an API call.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_RETRY_MAX_DELAY", "IBMCLOUD_RETRY_MAX_DELAY"}, 30),
+			},
+			"max_concurrent_requests_per_service": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of API calls sent in parallel to a single service. 0 means unlimited.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_MAX_CONCURRENT_REQUESTS_PER_SERVICE", "IBMCLOUD_MAX_CONCURRENT_REQUESTS_PER_SERVICE"}, 0),
+			},
+			"warn_on_missing_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Emit a warning for every resource found deleted outside of Terraform when it is refreshed, rather than silently removing it from the state.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_WARN_ON_MISSING_RESOURCES", "IBMCLOUD_WARN_ON_MISSING_RESOURCES"}, false),
+			},
+			"validate_availability": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check at plan time that the profile, the image and the zone of the VPC instances are available, and list the available alternatives when they are not.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_VALIDATE_AVAILABILITY", "IBMCLOUD_VALIDATE_AVAILABILITY"}, false),
+			},
+			"preflight_checks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check the credentials, the resource group and the region when the provider is configured, and report all the problems at once.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PREFLIGHT_CHECKS", "IBMCLOUD_PREFLIGHT_CHECKS"}, false),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -200,6 +247,13 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"cr_token_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Path of the compute resource token file of the IKS / Code Engine workload, used to authenticate with the trusted profile of iam_profile_id",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILE", "IBMCLOUD_CR_TOKEN_FILENAME"}, nil),
+				RequiredWith: []string{"iam_profile_id"},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -215,8 +269,8 @@ func Provider() *schema.Provider {
 			"visibility": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
-				Description:  "Visibility of the provider if it is private or public.",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private", "auto"}),
+				Description:  "Visibility of the provider if it is private or public. With auto, the private endpoints which can't be reached fall back to the public ones.",
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
 			},
 			"endpoints_file_path": {
@@ -225,6 +279,22 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "User tags that are attached to every resource that supports global tagging, in addition to the tags set on the resource. A resource tag overrides a default tag with the same key.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Custom endpoints for individual services. An endpoint set here takes precedence over the endpoints file and the visibility setting.",
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(),
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -347,6 +417,8 @@ func Provider() *schema.Provider {
 			"ibm_cloud_shell_account_settings":             cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                               cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                        cos.DataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects":                       cos.DataSourceIBMCosBucketObjects(),
+			"ibm_cos_presigned_url":                        cos.DataSourceIBMCosPresignedURL(),
 			"ibm_dns_domain_registration":                  classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
 			"ibm_dns_domain":                               classicinfrastructure.DataSourceIBMDNSDomain(),
 			"ibm_dns_secondary":                            classicinfrastructure.DataSourceIBMDNSSecondary(),
@@ -362,12 +434,15 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_template_versions":       iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateVersions(),
 			"ibm_iam_access_group_template_assignment":     iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateAssignment(),
 			"ibm_iam_account_settings":                     iamidentity.DataSourceIBMIAMAccountSettings(),
+			"ibm_iam_account_settings_summary":             iamidentity.DataSourceIBMIAMAccountSettingsSummary(),
 			"ibm_iam_auth_token":                           iamidentity.DataSourceIBMIAMAuthToken(),
 			"ibm_iam_role_actions":                         iampolicy.DataSourceIBMIAMRoleAction(),
 			"ibm_iam_users":                                iamidentity.DataSourceIBMIAMUsers(),
 			"ibm_iam_roles":                                iampolicy.DataSourceIBMIAMRole(),
 			"ibm_iam_user_policy":                          iampolicy.DataSourceIBMIAMUserPolicy(),
 			"ibm_iam_authorization_policies":               iampolicy.DataSourceIBMIAMAuthorizationPolicies(),
+			"ibm_iam_policies":                             iampolicy.DataSourceIBMIAMPolicies(),
+			"ibm_iam_user_effective_access":                iampolicy.DataSourceIBMIAMUserEffectiveAccess(),
 			"ibm_iam_user_profile":                         iamidentity.DataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                           iamidentity.DataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                       iampolicy.DataSourceIBMIAMServicePolicy(),
@@ -381,6 +456,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_links":                iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":                     iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":               iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_mfa_report":                           iamidentity.DataSourceIBMIamMfaReport(),
 			"ibm_iam_user_mfa_enrollments":                 iamidentity.DataSourceIBMIamUserMfaEnrollments(),
 			"ibm_iam_account_settings_template":            iamidentity.DataSourceIBMAccountSettingsTemplate(),
 			"ibm_iam_trusted_profile_template":             iamidentity.DataSourceIBMTrustedProfileTemplate(),
@@ -418,6 +494,7 @@ func Provider() *schema.Provider {
 
 			"ibm_is_dedicated_host":                  vpc.DataSourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_hosts":                 vpc.DataSourceIbmIsDedicatedHosts(),
+			"ibm_is_dedicated_host_capacity":         vpc.DataSourceIbmIsDedicatedHostCapacity(),
 			"ibm_is_dedicated_host_profile":          vpc.DataSourceIbmIsDedicatedHostProfile(),
 			"ibm_is_dedicated_host_profiles":         vpc.DataSourceIbmIsDedicatedHostProfiles(),
 			"ibm_is_dedicated_host_group":            vpc.DataSourceIbmIsDedicatedHostGroup(),
@@ -560,6 +637,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_server_routes":               vpc.DataSourceIBMIsVPNServerRoutes(),
 			"ibm_is_zone":                            vpc.DataSourceIBMISZone(),
 			"ibm_is_zones":                           vpc.DataSourceIBMISZones(),
+			"ibm_is_cidr_subnets":                    vpc.DataSourceIBMISCIDRSubnets(),
 			"ibm_is_operating_system":                vpc.DataSourceIBMISOperatingSystem(),
 			"ibm_is_operating_systems":               vpc.DataSourceIBMISOperatingSystems(),
 			"ibm_is_network_acls":                    vpc.DataSourceIBMIsNetworkAcls(),
@@ -596,6 +674,7 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
+			"ibm_resource_crn":      resourcecontroller.DataSourceIBMResourceCRN(),
 			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
 			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
 			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
@@ -782,7 +861,8 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.DataSourceIBMCmObject(),
 
 			// Added for Resource Tag
-			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resource_tag":          globaltagging.DataSourceIBMResourceTag(),
+			"ibm_cloud_resource_search": globaltagging.DataSourceIBMCloudResourceSearch(),
 
 			// Atracker
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
@@ -892,6 +972,7 @@ func Provider() *schema.Provider {
 			// Added for Toolchain
 			"ibm_cd_toolchain":                         cdtoolchain.DataSourceIBMCdToolchain(),
 			"ibm_cd_toolchains":                        cdtoolchain.DataSourceIBMCdToolchains(),
+			"ibm_cd_toolchain_tools":                   cdtoolchain.DataSourceIBMCdToolchainTools(),
 			"ibm_cd_toolchain_tool_keyprotect":         cdtoolchain.DataSourceIBMCdToolchainToolKeyprotect(),
 			"ibm_cd_toolchain_tool_secretsmanager":     cdtoolchain.DataSourceIBMCdToolchainToolSecretsmanager(),
 			"ibm_cd_toolchain_tool_bitbucketgit":       cdtoolchain.DataSourceIBMCdToolchainToolBitbucketgit(),
@@ -921,6 +1002,8 @@ func Provider() *schema.Provider {
 			"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.DataSourceIBMCdTektonPipelineProperty(),
 			"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.DataSourceIBMCdTektonPipelineTrigger(),
 			"ibm_cd_tekton_pipeline":                  cdtektonpipeline.DataSourceIBMCdTektonPipeline(),
+			"ibm_cd_tekton_pipeline_runs":             cdtektonpipeline.DataSourceIBMCdTektonPipelineRuns(),
+			"ibm_cd_tekton_pipeline_workers":          cdtektonpipeline.DataSourceIBMCdTektonPipelineWorkers(),
 
 			// Added for Code Engine
 			"ibm_code_engine_app":            codeengine.DataSourceIbmCodeEngineApp(),
@@ -1086,6 +1169,7 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":     cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":         cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_sync":                                 cos.ResourceIBMCOSSync(),
 			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1108,9 +1192,11 @@ func Provider() *schema.Provider {
 			"ibm_iam_custom_role":                          iampolicy.ResourceIBMIAMCustomRole(),
 			"ibm_iam_access_group_dynamic_rule":            iamaccessgroup.ResourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":                 iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
+			"ibm_iam_access_group_members_exclusive":       iamaccessgroup.ResourceIBMIAMAccessGroupMembersExclusive(),
 			"ibm_iam_access_group_policy":                  iampolicy.ResourceIBMIAMAccessGroupPolicy(),
 			"ibm_iam_authorization_policy":                 iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":          iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
+			"ibm_iam_authorization_policy_ensure":          iampolicy.ResourceIBMIAMAuthorizationPolicyEnsure(),
 			"ibm_iam_user_policy":                          iampolicy.ResourceIBMIAMUserPolicy(),
 			"ibm_iam_user_settings":                        iamidentity.ResourceIBMIAMUserSettings(),
 			"ibm_iam_service_id":                           iamidentity.ResourceIBMIAMServiceID(),
@@ -1171,6 +1257,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener":                            vpc.ResourceIBMISLBListener(),
 			"ibm_is_lb_listener_policy":                     vpc.ResourceIBMISLBListenerPolicy(),
 			"ibm_is_lb_listener_policy_rule":                vpc.ResourceIBMISLBListenerPolicyRule(),
+			"ibm_is_lb_listener_policies":                   vpc.ResourceIBMISLBListenerPolicies(),
 			"ibm_is_lb_pool":                                vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                         vpc.ResourceIBMISLBPoolMember(),
 			"ibm_is_network_acl":                            vpc.ResourceIBMISNetworkACL(),
@@ -1178,6 +1265,7 @@ func Provider() *schema.Provider {
 			"ibm_is_public_gateway":                         vpc.ResourceIBMISPublicGateway(),
 			"ibm_is_security_group":                         vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                    vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                   vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                  vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_share":                                  vpc.ResourceIbmIsShare(),
 			"ibm_is_share_replica_operations":               vpc.ResourceIbmIsShareReplicaOperations(),
@@ -1187,6 +1275,7 @@ func Provider() *schema.Provider {
 			"ibm_is_reservation":                            vpc.ResourceIBMISReservation(),
 			"ibm_is_reservation_activate":                   vpc.ResourceIBMISReservationActivate(),
 			"ibm_is_subnet_reserved_ip":                     vpc.ResourceIBMISReservedIP(),
+			"ibm_is_subnet_reserved_ip_pool":                vpc.ResourceIBMISSubnetReservedIPPool(),
 			"ibm_is_subnet_network_acl_attachment":          vpc.ResourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_subnet_public_gateway_attachment":       vpc.ResourceIBMISSubnetPublicGatewayAttachment(),
 			"ibm_is_subnet_routing_table_attachment":        vpc.ResourceIBMISSubnetRoutingTableAttachment(),
@@ -1204,6 +1293,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_dns_resolution_binding":             vpc.ResourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_routing_table":                      vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                vpc.ResourceIBMISVPCRoutingTableRoute(),
+			"ibm_is_vpc_routing_table_routes":               vpc.ResourceIBMISVPCRoutingTableRoutes(),
 			"ibm_is_vpn_server":                             vpc.ResourceIBMIsVPNServer(),
 			"ibm_is_vpn_server_client":                      vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                       vpc.ResourceIBMIsVPNServerRoute(),
@@ -1322,6 +1412,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_connection_prefix_filter": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilter(),
 			"ibm_tg_route_report":             transitgateway.ResourceIBMTransitGatewayRouteReport(),
 			"ibm_tg_connection_rgre_tunnel":   transitgateway.ResourceIBMTransitGatewayConnectionRgreTunnel(),
+			"ibm_tg_vpc_peering":              transitgateway.ResourceIBMTransitGatewayVpcPeering(),
 
 			// Catalog related resources
 			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
@@ -1476,6 +1567,7 @@ func Provider() *schema.Provider {
 
 			// Added for Toolchain
 			"ibm_cd_toolchain":                         cdtoolchain.ResourceIBMCdToolchain(),
+			"ibm_cd_toolchain_tool":                    cdtoolchain.ResourceIBMCdToolchainTool(),
 			"ibm_cd_toolchain_tool_keyprotect":         cdtoolchain.ResourceIBMCdToolchainToolKeyprotect(),
 			"ibm_cd_toolchain_tool_secretsmanager":     cdtoolchain.ResourceIBMCdToolchainToolSecretsmanager(),
 			"ibm_cd_toolchain_tool_bitbucketgit":       cdtoolchain.ResourceIBMCdToolchainToolBitbucketgit(),
@@ -1505,6 +1597,9 @@ func Provider() *schema.Provider {
 			"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.ResourceIBMCdTektonPipelineProperty(),
 			"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTrigger(),
 			"ibm_cd_tekton_pipeline":                  cdtektonpipeline.ResourceIBMCdTektonPipeline(),
+			"ibm_cd_tekton_pipeline_run":              cdtektonpipeline.ResourceIBMCdTektonPipelineRun(),
+			"ibm_cd_tekton_pipeline_properties":       cdtektonpipeline.ResourceIBMCdTektonPipelineProperties(),
+			"ibm_cd_tekton_pipeline_definitions":      cdtektonpipeline.ResourceIBMCdTektonPipelineDefinitions(),
 
 			// Added for Code Engine
 			"ibm_code_engine_app":            codeengine.ResourceIbmCodeEngineApp(),
@@ -1580,7 +1675,7 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
 		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
-		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
+		CustomizeDiff:        wrapCustomizeDiff(name, withCrossFieldRules(name, withDefaultResourceGroup(resource))),
 		Importer:             resource.Importer,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
@@ -1614,17 +1709,38 @@ func wrapFunction(
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	if function != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-			return function(context, schema, meta)
+			id := schema.Id()
+			return warnOnMissingResource(function(context, schema, meta), resourceName, operationName, isDataSource, id, schema, meta)
 		}
 	} else if fallback != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+			id := schema.Id()
+			return warnOnMissingResource(wrapError(fallback(schema, meta), resourceName, operationName, isDataSource), resourceName, operationName, isDataSource, id, schema, meta)
 		}
 	}
 
 	return nil
 }
 
+// warnOnMissingResource adds a warning to the diagnostics of a resource read
+// which removed the resource from the state, because it was deleted outside of
+// Terraform, when the provider sets warn_on_missing_resources.
+func warnOnMissingResource(diags diag.Diagnostics, resourceName, operationName string, isDataSource bool, id string, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if isDataSource || operationName != "read" || id == "" || d.Id() != "" || diags.HasError() {
+		return diags
+	}
+	if session, ok := meta.(conns.ClientSession); !ok || !session.WarnOnMissingResources() {
+		return diags
+	}
+	log.Printf("[WARN] %s %s was not found, removing it from the state", resourceName, id)
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s %s no longer exists", resourceName, id),
+		Detail: fmt.Sprintf("The %s resource with ID %s was not found when refreshing it, it was probably deleted outside of Terraform. "+
+			"It is removed from the state and will be re-created by the next apply if it is still in the configuration.", resourceName, id),
+	})
+}
+
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
 	if err == nil {
 		return nil
@@ -1657,6 +1773,35 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	)
 }
 
+// withDefaultResourceGroup adds the provider resource_group_id as the default
+// resource group of the resources which accept one.
+func withDefaultResourceGroup(resource *schema.Resource) schema.CustomizeDiffFunc {
+	key := flex.ResourceGroupKey(resource.Schema)
+	if key == "" {
+		return resource.CustomizeDiff
+	}
+	defaultResourceGroup := func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return flex.ResourceGroupCustomizeDiff(diff, meta, key)
+	}
+	if resource.CustomizeDiff == nil {
+		return defaultResourceGroup
+	}
+	return customdiff.Sequence(defaultResourceGroup, resource.CustomizeDiff)
+}
+
+// withCrossFieldRules checks the cross field rules registered in the validator
+// of the resource before its own CustomizeDiff.
+func withCrossFieldRules(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	crossFieldRules := validate.InvokeCrossFieldValidator(resourceName)
+	if crossFieldRules == nil {
+		return function
+	}
+	if function == nil {
+		return crossFieldRules
+	}
+	return customdiff.Sequence(crossFieldRules, function)
+}
+
 func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	if function == nil {
 		return nil
@@ -1829,6 +1974,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_ssh_key":                          vpc.ResourceIBMISSHKeyValidator(),
 				"ibm_is_subnet":                           vpc.ResourceIBMISSubnetValidator(),
 				"ibm_is_subnet_reserved_ip":               vpc.ResourceIBMISSubnetReservedIPValidator(),
+				"ibm_is_subnet_reserved_ip_pool":          vpc.ResourceIBMISSubnetReservedIPPoolValidator(),
 				"ibm_is_volume":                           vpc.ResourceIBMISVolumeValidator(),
 				"ibm_is_virtual_network_interface":        vpc.ResourceIBMIsVirtualNetworkInterfaceValidator(),
 				"ibm_is_address_prefix":                   vpc.ResourceIBMISAddressPrefixValidator(),
@@ -1881,6 +2027,7 @@ func Validator() validate.ValidatorDict {
 
 				// Added for Toolchains
 				"ibm_cd_toolchain":                         cdtoolchain.ResourceIBMCdToolchainValidator(),
+				"ibm_cd_toolchain_tool":                    cdtoolchain.ResourceIBMCdToolchainToolValidator(),
 				"ibm_cd_toolchain_tool_keyprotect":         cdtoolchain.ResourceIBMCdToolchainToolKeyprotectValidator(),
 				"ibm_cd_toolchain_tool_secretsmanager":     cdtoolchain.ResourceIBMCdToolchainToolSecretsmanagerValidator(),
 				"ibm_cd_toolchain_tool_bitbucketgit":       cdtoolchain.ResourceIBMCdToolchainToolBitbucketgitValidator(),
@@ -1909,6 +2056,9 @@ func Validator() validate.ValidatorDict {
 				"ibm_cd_tekton_pipeline_trigger_property": cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerPropertyValidator(),
 				"ibm_cd_tekton_pipeline_property":         cdtektonpipeline.ResourceIBMCdTektonPipelinePropertyValidator(),
 				"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerValidator(),
+				"ibm_cd_tekton_pipeline_run":              cdtektonpipeline.ResourceIBMCdTektonPipelineRunValidator(),
+				"ibm_cd_tekton_pipeline_properties":       cdtektonpipeline.ResourceIBMCdTektonPipelinePropertiesValidator(),
+				"ibm_cd_tekton_pipeline_definitions":      cdtektonpipeline.ResourceIBMCdTektonPipelineDefinitionsValidator(),
 
 				"ibm_container_addons":                      kubernetes.ResourceIBMContainerAddOnsValidator(),
 				"ibm_container_alb_create":                  kubernetes.ResourceIBMContainerAlbCreateValidator(),
@@ -1925,6 +2075,7 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
 				"ibm_iam_access_group_members":             iamaccessgroup.ResourceIBMIAMAccessGroupMembersValidator(),
+				"ibm_iam_access_group_members_exclusive":   iamaccessgroup.ResourceIBMIAMAccessGroupMembersExclusiveValidator(),
 				"ibm_iam_access_group_template":            iamaccessgroup.ResourceIBMIAMAccessGroupTemplateValidator(),
 				"ibm_iam_access_group_template_version":    iamaccessgroup.ResourceIBMIAMAccessGroupTemplateVersionValidator(),
 				"ibm_iam_access_group_template_assignment": iamaccessgroup.ResourceIBMIAMAccessGroupTemplateAssignmentValidator(),
@@ -2076,6 +2227,19 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema)
+	for _, service := range conns.EndpointServices() {
+		endpoints[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("Custom endpoint for the %s service.", service),
+		}
+	}
+	return endpoints
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
@@ -2095,6 +2259,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if ttoken, ok := d.GetOk("iam_profile_id"); ok {
 		iamTrustedProfileId = ttoken.(string)
 	}
+	var crTokenFile string
+	if file, ok := d.GetOk("cr_token_file"); ok {
+		crTokenFile = file.(string)
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -2136,11 +2304,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	endpoints := make(map[string]string)
+	if e, ok := d.GetOk("endpoints"); ok && len(e.([]interface{})) > 0 && e.([]interface{})[0] != nil {
+		for service, url := range e.([]interface{})[0].(map[string]interface{}) {
+			if url.(string) != "" {
+				endpoints[service] = url.(string)
+			}
+		}
+	}
+
+	var defaultTags []string
+	if t, ok := d.GetOk("default_tags"); ok {
+		defaultTags = flex.ExpandStringList(t.(*schema.Set).List())
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
+	defaultResourceGrp := d.Get("resource_group_id").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryDelay := d.Get("retry_delay").(int)
+	retryMaxDelay := d.Get("retry_max_delay").(int)
+	maxConcurrentRequests := d.Get("max_concurrent_requests_per_service").(int)
+	warnOnMissingResources := d.Get("warn_on_missing_resources").(bool)
+	validateAvailability := d.Get("validate_availability").(bool)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -2154,25 +2341,40 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:          bluemixAPIKey,
+		Region:                 region,
+		ResourceGroup:          resourceGrp,
+		DefaultResourceGroupID: defaultResourceGrp,
+		BluemixTimeout:         time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:       time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:      softlayerUsername,
+		SoftLayerAPIKey:        softlayerAPIKey,
+		RetryCount:             retryCount,
+		SoftLayerEndpointURL:   softlayerEndpointUrl,
+		RetryDelay:             time.Duration(retryDelay) * time.Second,
+		RetryMaxDelay:          time.Duration(retryMaxDelay) * time.Second,
+		MaxConcurrentRequests:  maxConcurrentRequests,
+		WarnOnMissingResources: warnOnMissingResources,
+		ValidateAvailability:   validateAvailability,
+		DefaultTags:            defaultTags,
+		FunctionNameSpace:      wskNameSpace,
+		RiaasEndPoint:          riaasEndPoint,
+		IAMToken:               iamToken,
+		IAMRefreshToken:        iamRefreshToken,
+		Zone:                   zone,
+		Visibility:             visibility,
+		EndpointsFile:          file,
+		Endpoints:              endpoints,
+		IAMTrustedProfileID:    iamTrustedProfileId,
+		CRTokenFile:            crTokenFile,
 	}
 
-	return config.ClientSession()
+	sess, err := config.ClientSession()
+	if err != nil || !d.Get("preflight_checks").(bool) {
+		return sess, err
+	}
+	if err := conns.Preflight(sess.(conns.ClientSession), &config); err != nil {
+		return nil, err
+	}
+	return sess, nil
 }
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+)
+
+func DataSourceIBMCdTektonPipelineRuns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCdTektonPipelineRunsRead,
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Tekton pipeline ID.",
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pending", "waiting", "queued", "running", "cancelled", "cancelling", "failed", "error", "succeeded"}, false),
+				Description:  "Only return the runs with this status.",
+			},
+			"trigger_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the runs started by the trigger with this name.",
+			},
+			"created_after": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only return the runs created after this RFC 3339 date time.",
+			},
+			"created_before": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only return the runs created before this RFC 3339 date time.",
+			},
+			"pipeline_runs": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The runs of the pipeline, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the pipeline run.",
+						},
+						"definition_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The aggregated definition ID.",
+						},
+						"run_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL for the details page of this pipeline run.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "API URL for interacting with the pipeline run.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Standard RFC 3339 Date Time String.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Standard RFC 3339 Date Time String.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCdTektonPipelineRunsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listTektonPipelineRunsOptions := &cdtektonpipelinev2.ListTektonPipelineRunsOptions{}
+
+	listTektonPipelineRunsOptions.SetPipelineID(d.Get("pipeline_id").(string))
+	if _, ok := d.GetOk("status"); ok {
+		listTektonPipelineRunsOptions.SetStatus(d.Get("status").(string))
+	}
+	if _, ok := d.GetOk("trigger_name"); ok {
+		listTektonPipelineRunsOptions.SetTriggerName(d.Get("trigger_name").(string))
+	}
+
+	var createdAfter, createdBefore time.Time
+	if v, ok := d.GetOk("created_after"); ok {
+		createdAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		createdBefore, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	pager, err := cdTektonPipelineClient.NewTektonPipelineRunsPager(listTektonPipelineRunsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The runs are listed most recent first, so the listing stops at the
+	// first run created before created_after.
+	pipelineRuns := []map[string]interface{}{}
+	for pager.HasNext() {
+		nextPage, err := pager.GetNextWithContext(context)
+		if err != nil {
+			log.Printf("[DEBUG] TektonPipelineRunsPager.GetNext() failed %s", err)
+			return diag.FromErr(fmt.Errorf("TektonPipelineRunsPager.GetNext() failed %s", err))
+		}
+
+		done := false
+		for _, run := range nextPage {
+			createdAt := dataSourceIBMCdTektonPipelineRunsTime(run.CreatedAt)
+			if !createdAfter.IsZero() && createdAt.Before(createdAfter) {
+				done = true
+				break
+			}
+			if !createdBefore.IsZero() && !createdAt.Before(createdBefore) {
+				continue
+			}
+			pipelineRuns = append(pipelineRuns, dataSourceIBMCdTektonPipelineRunsPipelineRunToMap(&run))
+		}
+		if done {
+			break
+		}
+	}
+
+	d.SetId(d.Get("pipeline_id").(string))
+	if err = d.Set("pipeline_runs", pipelineRuns); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pipeline_runs %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMCdTektonPipelineRunsTime(dt *strfmt.DateTime) time.Time {
+	if dt == nil {
+		return time.Time{}
+	}
+	return time.Time(*dt)
+}
+
+func dataSourceIBMCdTektonPipelineRunsPipelineRunToMap(model *cdtektonpipelinev2.PipelineRunsCollectionPipelineRunsItem) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["id"] = flex.StringValue(model.ID)
+	modelMap["status"] = flex.StringValue(model.Status)
+	modelMap["definition_id"] = flex.StringValue(model.DefinitionID)
+	modelMap["run_url"] = flex.StringValue(model.RunURL)
+	modelMap["href"] = flex.StringValue(model.Href)
+	modelMap["created_at"] = flex.DateTimeToString(model.CreatedAt)
+	modelMap["updated_at"] = flex.DateTimeToString(model.UpdatedAt)
+	return modelMap
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+)
+
+// Private workers are bound to a toolchain as tools of this type, the ID of
+// the tool being the ID of the worker.
+const privateWorkerToolTypeID = "private_worker"
+
+func DataSourceIBMCdTektonPipelineWorkers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCdTektonPipelineWorkersRead,
+
+		Schema: map[string]*schema.Schema{
+			"toolchain_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the toolchain the private workers are bound to.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the private worker with this name.",
+			},
+			"workers": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The private workers available to the pipelines of the toolchain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the worker, to use as the `worker` ID of a pipeline or trigger.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the worker.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the worker.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current configuration state of the worker integration.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCdTektonPipelineWorkersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toolchainID := d.Get("toolchain_id").(string)
+	listToolsOptions := &cdtoolchainv2.ListToolsOptions{}
+	listToolsOptions.SetToolchainID(toolchainID)
+
+	pager, err := cdToolchainClient.NewToolsPager(listToolsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] ToolsPager.GetAll() failed %s", err)
+		return diag.FromErr(fmt.Errorf("ToolsPager.GetAll() failed %s", err))
+	}
+
+	name := d.Get("name").(string)
+	workers := []map[string]interface{}{}
+	for _, tool := range allItems {
+		if flex.StringValue(tool.ToolTypeID) != privateWorkerToolTypeID {
+			continue
+		}
+		workerName, _ := tool.Parameters["name"].(string)
+		if name != "" && workerName != name {
+			continue
+		}
+		workers = append(workers, map[string]interface{}{
+			"id":    flex.StringValue(tool.ID),
+			"name":  workerName,
+			"type":  "private",
+			"state": flex.StringValue(tool.State),
+		})
+	}
+
+	d.SetId(toolchainID)
+	if err = d.Set("workers", workers); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting workers %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCdTektonPipelineWorkersDataSourceBasic(t *testing.T) {
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	rgName := acc.CdResourceGroupName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineWorkersDataSourceConfigBasic(tcName, rgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cd_tekton_pipeline_workers.cd_tekton_pipeline_workers", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cd_tekton_pipeline_workers.cd_tekton_pipeline_workers", "workers.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cd_tekton_pipeline_workers.cd_tekton_pipeline_workers", "workers.0.name", "private-worker-tool-01"),
+					resource.TestCheckResourceAttr("data.ibm_cd_tekton_pipeline_workers.cd_tekton_pipeline_workers", "workers.0.type", "private"),
+					resource.TestCheckResourceAttrPair("data.ibm_cd_tekton_pipeline_workers.cd_tekton_pipeline_workers", "workers.0.id", "ibm_cd_toolchain_tool_privateworker.cd_toolchain_tool_privateworker", "tool_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdTektonPipelineWorkersDataSourceConfigBasic(tcName string, rgName string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+
+		resource "ibm_cd_toolchain_tool_privateworker" "cd_toolchain_tool_privateworker" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "private-worker-tool-01"
+				worker_queue_credentials = "<worker_queue_credentials>"
+			}
+		}
+
+		data "ibm_cd_tekton_pipeline_workers" "cd_tekton_pipeline_workers" {
+			toolchain_id = ibm_cd_toolchain_tool_privateworker.cd_toolchain_tool_privateworker.toolchain_id
+			name = "private-worker-tool-01"
+		}
+	`, rgName, tcName)
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCdTektonPipelineDefinitions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdTektonPipelineDefinitionsCreate,
+		ReadContext:   resourceIBMCdTektonPipelineDefinitionsRead,
+		UpdateContext: resourceIBMCdTektonPipelineDefinitionsUpdate,
+		DeleteContext: resourceIBMCdTektonPipelineDefinitionsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_definitions", "pipeline_id"),
+				Description:  "The Tekton pipeline ID.",
+			},
+			"url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "URL of the definition repository.",
+			},
+			"definition": &schema.Schema{
+				Type:        schema.TypeList,
+				MinItems:    1,
+				Required:    true,
+				Description: "The branches or tags and paths of the repository containing Tekton pipeline definitions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A branch from the repo, specify one of branch or tag only.",
+						},
+						"tag": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A tag from the repo, specify one of branch or tag only.",
+						},
+						"path": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path to the definition's YAML files.",
+						},
+						"definition_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The aggregated definition ID.",
+						},
+					},
+				},
+			},
+			"definition_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The aggregated definition IDs, in the order of the definitions.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCdTektonPipelineDefinitionsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "pipeline_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-z]+$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_tekton_pipeline_definitions", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCdTektonPipelineDefinitionsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pipelineID := d.Get("pipeline_id").(string)
+
+	definitionIDs, diags := reconcileTektonPipelineDefinitions(context, d, meta, pipelineID, nil)
+	if len(definitionIDs) > 0 {
+		// Keep the definitions created before a failure in the state.
+		d.SetId(fmt.Sprintf("%s/%s", pipelineID, strings.Join(definitionIDs, ",")))
+	}
+	if diags != nil {
+		return diags
+	}
+
+	return resourceIBMCdTektonPipelineDefinitionsRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelineDefinitionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := ""
+	definitions := []map[string]interface{}{}
+	definitionIDs := []string{}
+	for _, definitionID := range strings.Split(parts[1], ",") {
+		getTektonPipelineDefinitionOptions := &cdtektonpipelinev2.GetTektonPipelineDefinitionOptions{}
+		getTektonPipelineDefinitionOptions.SetPipelineID(parts[0])
+		getTektonPipelineDefinitionOptions.SetDefinitionID(definitionID)
+
+		definition, response, err := cdTektonPipelineClient.GetTektonPipelineDefinitionWithContext(context, getTektonPipelineDefinitionOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			log.Printf("[DEBUG] GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		}
+
+		properties := definition.Source.Properties
+		url = flex.StringValue(properties.URL)
+		definitionMap := map[string]interface{}{
+			"branch":        flex.StringValue(properties.Branch),
+			"tag":           flex.StringValue(properties.Tag),
+			"path":          flex.StringValue(properties.Path),
+			"definition_id": flex.StringValue(definition.ID),
+		}
+		definitions = append(definitions, definitionMap)
+		definitionIDs = append(definitionIDs, flex.StringValue(definition.ID))
+	}
+
+	if len(definitions) == 0 {
+		d.SetId("")
+		return nil
+	}
+	// The definitions deleted outside of Terraform are dropped from the ID,
+	// so that the next apply creates them again instead of replacing them.
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], strings.Join(definitionIDs, ",")))
+
+	if err = d.Set("pipeline_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pipeline_id: %s", err))
+	}
+	if err = d.Set("url", url); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting url: %s", err))
+	}
+	if err = d.Set("definition", definitions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting definition: %s", err))
+	}
+	if err = d.Set("definition_ids", definitionIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting definition_ids: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCdTektonPipelineDefinitionsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("definition") {
+		parts, err := flex.SepIdParts(d.Id(), "/")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		definitionIDs, diags := reconcileTektonPipelineDefinitions(context, d, meta, parts[0], strings.Split(parts[1], ","))
+		if len(definitionIDs) > 0 {
+			d.SetId(fmt.Sprintf("%s/%s", parts[0], strings.Join(definitionIDs, ",")))
+		}
+		if diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCdTektonPipelineDefinitionsRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelineDefinitionsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, definitionID := range strings.Split(parts[1], ",") {
+		deleteTektonPipelineDefinitionOptions := &cdtektonpipelinev2.DeleteTektonPipelineDefinitionOptions{}
+		deleteTektonPipelineDefinitionOptions.SetPipelineID(parts[0])
+		deleteTektonPipelineDefinitionOptions.SetDefinitionID(definitionID)
+
+		response, err := cdTektonPipelineClient.DeleteTektonPipelineDefinitionWithContext(context, deleteTektonPipelineDefinitionOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// reconcileTektonPipelineDefinitions replaces the current definitions with
+// the configured ones in order, then creates or deletes the remaining ones.
+// It returns the IDs of the definitions which exist afterwards, also on error.
+func reconcileTektonPipelineDefinitions(context context.Context, d *schema.ResourceData, meta interface{}, pipelineID string, current []string) ([]string, diag.Diagnostics) {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return current, diag.FromErr(err)
+	}
+
+	url := d.Get("url").(string)
+	definitions := d.Get("definition").([]interface{})
+	definitionIDs := []string{}
+	for i, v := range definitions {
+		source := resourceIBMCdTektonPipelineDefinitionsMapToDefinitionSource(url, v.(map[string]interface{}))
+
+		if i < len(current) {
+			if !d.HasChange(fmt.Sprintf("definition.%d", i)) {
+				definitionIDs = append(definitionIDs, current[i])
+				continue
+			}
+			replaceTektonPipelineDefinitionOptions := &cdtektonpipelinev2.ReplaceTektonPipelineDefinitionOptions{}
+			replaceTektonPipelineDefinitionOptions.SetPipelineID(pipelineID)
+			replaceTektonPipelineDefinitionOptions.SetDefinitionID(current[i])
+			replaceTektonPipelineDefinitionOptions.SetSource(source)
+
+			_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineDefinitionWithContext(context, replaceTektonPipelineDefinitionOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
+				return append(definitionIDs, current[i:]...), diag.FromErr(fmt.Errorf("ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+			}
+			definitionIDs = append(definitionIDs, current[i])
+			continue
+		}
+
+		createTektonPipelineDefinitionOptions := &cdtektonpipelinev2.CreateTektonPipelineDefinitionOptions{}
+		createTektonPipelineDefinitionOptions.SetPipelineID(pipelineID)
+		createTektonPipelineDefinitionOptions.SetSource(source)
+
+		definition, response, err := cdTektonPipelineClient.CreateTektonPipelineDefinitionWithContext(context, createTektonPipelineDefinitionOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
+			return definitionIDs, diag.FromErr(fmt.Errorf("CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		}
+		definitionIDs = append(definitionIDs, *definition.ID)
+	}
+
+	for i := len(definitions); i < len(current); i++ {
+		deleteTektonPipelineDefinitionOptions := &cdtektonpipelinev2.DeleteTektonPipelineDefinitionOptions{}
+		deleteTektonPipelineDefinitionOptions.SetPipelineID(pipelineID)
+		deleteTektonPipelineDefinitionOptions.SetDefinitionID(current[i])
+
+		response, err := cdTektonPipelineClient.DeleteTektonPipelineDefinitionWithContext(context, deleteTektonPipelineDefinitionOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
+			return append(definitionIDs, current[i:]...), diag.FromErr(fmt.Errorf("DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return definitionIDs, nil
+}
+
+func resourceIBMCdTektonPipelineDefinitionsMapToDefinitionSource(url string, modelMap map[string]interface{}) *cdtektonpipelinev2.DefinitionSource {
+	properties := &cdtektonpipelinev2.DefinitionSourceProperties{}
+	properties.URL = core.StringPtr(url)
+	if modelMap["branch"] != nil && modelMap["branch"].(string) != "" {
+		properties.Branch = core.StringPtr(modelMap["branch"].(string))
+	}
+	if modelMap["tag"] != nil && modelMap["tag"].(string) != "" {
+		properties.Tag = core.StringPtr(modelMap["tag"].(string))
+	}
+	properties.Path = core.StringPtr(modelMap["path"].(string))
+
+	model := &cdtektonpipelinev2.DefinitionSource{}
+	model.Type = core.StringPtr("git")
+	model.Properties = properties
+	return model
+}
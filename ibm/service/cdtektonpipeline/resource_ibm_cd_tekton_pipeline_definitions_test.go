@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCdTektonPipelineDefinitionsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineDefinitionsConfigBasic(`
+					definition {
+						branch = "master"
+						path = ".tekton"
+					}
+					definition {
+						branch = "master"
+						path = ".tekton/tasks"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions", "id"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions", "definition_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions", "definition.1.definition_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineDefinitionsConfigBasic(`
+					definition {
+						branch = "master"
+						path = ".tekton"
+					}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions", "definition_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions", "definition.0.path", ".tekton"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cd_tekton_pipeline_definitions.cd_tekton_pipeline_definitions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdTektonPipelineDefinitionsConfigBasic(definitions string) string {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+		resource "ibm_cd_toolchain_tool_pipeline" "ibm_cd_toolchain_tool_pipeline" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "pipeline-name"
+			}
+		}
+		resource "ibm_cd_tekton_pipeline" "cd_tekton_pipeline" {
+			pipeline_id = ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline.tool_id
+			worker {
+				id = "public"
+			}
+			depends_on = [
+				ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline
+			]
+		}
+		resource "ibm_cd_toolchain_tool_githubconsolidated" "definition-repo" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			name = "definition-repo"
+			initialization {
+				type = "link"
+				repo_url = "https://github.com/open-toolchain/hello-tekton.git"
+			}
+			parameters {}
+		}
+		resource "ibm_cd_tekton_pipeline_definitions" "cd_tekton_pipeline_definitions" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			url = "https://github.com/open-toolchain/hello-tekton.git"
+			%s
+			depends_on = [
+				ibm_cd_toolchain_tool_githubconsolidated.definition-repo
+			]
+		}
+	`, rgName, tcName, definitions)
+}
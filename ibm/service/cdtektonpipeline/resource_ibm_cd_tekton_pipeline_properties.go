@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+)
+
+func ResourceIBMCdTektonPipelineProperties() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdTektonPipelinePropertiesCreate,
+		ReadContext:   resourceIBMCdTektonPipelinePropertiesRead,
+		UpdateContext: resourceIBMCdTektonPipelinePropertiesUpdate,
+		DeleteContext: resourceIBMCdTektonPipelinePropertiesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_properties", "pipeline_id"),
+				Description:  "The Tekton pipeline ID.",
+			},
+			"property": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of environment properties of the pipeline. Properties of the pipeline which aren't listed are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_properties", "name"),
+							Description:  "Property name.",
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_properties", "type"),
+							Description:  "Property type.",
+						},
+						"value": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_properties", "value"),
+							Description:  "Property value. Any string value is valid.",
+						},
+						"enum": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Options for `single_select` property type. Only needed when using `single_select` property type.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"locked": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When true, this property cannot be overridden by a trigger property or at runtime. Attempting to override it will result in run requests being rejected. The default is false.",
+						},
+						"path": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_properties", "path"),
+							Description:  "A dot notation path for `integration` type properties only, that selects a value from the tool integration. If left blank the full tool integration data will be used.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCdTektonPipelinePropertiesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "pipeline_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-z]+$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-zA-Z_.]{1,253}$`,
+			MinValueLength:             1,
+			MaxValueLength:             253,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "value",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^.*$`,
+			MinValueLength:             0,
+			MaxValueLength:             4096,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "appconfig, integration, secure, single_select, text",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "path",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[-0-9a-zA-Z_.]*$`,
+			MinValueLength:             0,
+			MaxValueLength:             4096,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_tekton_pipeline_properties", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCdTektonPipelinePropertiesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pipelineID := d.Get("pipeline_id").(string)
+	if diags := reconcileTektonPipelineProperties(context, d, meta, pipelineID); diags != nil {
+		return diags
+	}
+
+	d.SetId(pipelineID)
+
+	return resourceIBMCdTektonPipelinePropertiesRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelinePropertiesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listTektonPipelinePropertiesOptions := &cdtektonpipelinev2.ListTektonPipelinePropertiesOptions{}
+	listTektonPipelinePropertiesOptions.SetPipelineID(d.Id())
+
+	propertiesCollection, response, err := cdTektonPipelineClient.ListTektonPipelinePropertiesWithContext(context, listTektonPipelinePropertiesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+	}
+
+	// The API returns a hash of the secure values, the configured values are
+	// kept when they match the hash.
+	configured := map[string]string{}
+	for _, v := range d.Get("property").(*schema.Set).List() {
+		property := v.(map[string]interface{})
+		configured[property["name"].(string)] = property["value"].(string)
+	}
+
+	properties := []map[string]interface{}{}
+	for _, property := range propertiesCollection.Properties {
+		propertyMap := resourceIBMCdTektonPipelinePropertiesPropertyToMap(&property)
+		if flex.StringValue(property.Type) == "secure" {
+			if value, ok := configured[flex.StringValue(property.Name)]; ok &&
+				flex.HashPipelinePropertySecret(d.Id(), flex.StringValue(property.Name), value) == flex.StringValue(property.Value) {
+				propertyMap["value"] = value
+			}
+		}
+		properties = append(properties, propertyMap)
+	}
+
+	if err = d.Set("pipeline_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pipeline_id: %s", err))
+	}
+	if err = d.Set("property", properties); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting property: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCdTektonPipelinePropertiesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("property") {
+		if diags := reconcileTektonPipelineProperties(context, d, meta, d.Id()); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCdTektonPipelinePropertiesRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelinePropertiesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, v := range d.Get("property").(*schema.Set).List() {
+		property := v.(map[string]interface{})
+
+		deleteTektonPipelinePropertyOptions := &cdtektonpipelinev2.DeleteTektonPipelinePropertyOptions{}
+		deleteTektonPipelinePropertyOptions.SetPipelineID(d.Id())
+		deleteTektonPipelinePropertyOptions.SetPropertyName(property["name"].(string))
+
+		response, err := cdTektonPipelineClient.DeleteTektonPipelinePropertyWithContext(context, deleteTektonPipelinePropertyOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// reconcileTektonPipelineProperties lists the properties of the pipeline once
+// and only creates, replaces or deletes the properties which differ from the
+// configuration.
+func reconcileTektonPipelineProperties(context context.Context, d *schema.ResourceData, meta interface{}, pipelineID string) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listTektonPipelinePropertiesOptions := &cdtektonpipelinev2.ListTektonPipelinePropertiesOptions{}
+	listTektonPipelinePropertiesOptions.SetPipelineID(pipelineID)
+
+	propertiesCollection, response, err := cdTektonPipelineClient.ListTektonPipelinePropertiesWithContext(context, listTektonPipelinePropertiesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+	}
+
+	current := map[string]cdtektonpipelinev2.Property{}
+	for _, property := range propertiesCollection.Properties {
+		current[flex.StringValue(property.Name)] = property
+	}
+
+	desired := map[string]map[string]interface{}{}
+	for _, v := range d.Get("property").(*schema.Set).List() {
+		property := v.(map[string]interface{})
+		desired[property["name"].(string)] = property
+	}
+
+	for name, property := range current {
+		if want, ok := desired[name]; ok && want["type"].(string) == flex.StringValue(property.Type) {
+			continue
+		}
+		// The type of a property can't be changed, the property is created
+		// again below.
+		deleteTektonPipelinePropertyOptions := &cdtektonpipelinev2.DeleteTektonPipelinePropertyOptions{}
+		deleteTektonPipelinePropertyOptions.SetPipelineID(pipelineID)
+		deleteTektonPipelinePropertyOptions.SetPropertyName(name)
+
+		response, err := cdTektonPipelineClient.DeleteTektonPipelinePropertyWithContext(context, deleteTektonPipelinePropertyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		}
+		delete(current, name)
+	}
+
+	for name, want := range desired {
+		enum := []string{}
+		for _, v := range want["enum"].([]interface{}) {
+			enum = append(enum, v.(string))
+		}
+
+		property, exists := current[name]
+		if !exists {
+			createTektonPipelinePropertiesOptions := &cdtektonpipelinev2.CreateTektonPipelinePropertiesOptions{}
+			createTektonPipelinePropertiesOptions.SetPipelineID(pipelineID)
+			createTektonPipelinePropertiesOptions.SetName(name)
+			createTektonPipelinePropertiesOptions.SetType(want["type"].(string))
+			if value := want["value"].(string); value != "" {
+				createTektonPipelinePropertiesOptions.SetValue(value)
+			}
+			if len(enum) > 0 {
+				createTektonPipelinePropertiesOptions.SetEnum(enum)
+			}
+			if locked := want["locked"].(bool); locked {
+				createTektonPipelinePropertiesOptions.SetLocked(locked)
+			}
+			if path := want["path"].(string); path != "" {
+				createTektonPipelinePropertiesOptions.SetPath(path)
+			}
+
+			_, response, err := cdTektonPipelineClient.CreateTektonPipelinePropertiesWithContext(context, createTektonPipelinePropertiesOptions)
+			if err != nil {
+				log.Printf("[DEBUG] CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+			}
+			continue
+		}
+
+		value := want["value"].(string)
+		currentValue := flex.StringValue(property.Value)
+		if want["type"].(string) == "secure" {
+			value = flex.HashPipelinePropertySecret(pipelineID, name, value)
+		}
+		currentEnum := property.Enum
+		if currentEnum == nil {
+			currentEnum = []string{}
+		}
+		currentLocked := property.Locked != nil && *property.Locked
+		if value == currentValue && reflect.DeepEqual(enum, currentEnum) &&
+			want["locked"].(bool) == currentLocked && want["path"].(string) == flex.StringValue(property.Path) {
+			continue
+		}
+
+		replaceTektonPipelinePropertyOptions := &cdtektonpipelinev2.ReplaceTektonPipelinePropertyOptions{}
+		replaceTektonPipelinePropertyOptions.SetPipelineID(pipelineID)
+		replaceTektonPipelinePropertyOptions.SetPropertyName(name)
+		replaceTektonPipelinePropertyOptions.SetName(name)
+		replaceTektonPipelinePropertyOptions.SetType(want["type"].(string))
+		replaceTektonPipelinePropertyOptions.SetValue(want["value"].(string))
+		replaceTektonPipelinePropertyOptions.SetLocked(want["locked"].(bool))
+		if want["type"].(string) == "single_select" {
+			replaceTektonPipelinePropertyOptions.SetEnum(enum)
+		}
+		if want["type"].(string) == "integration" {
+			replaceTektonPipelinePropertyOptions.SetPath(want["path"].(string))
+		}
+
+		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelinePropertyWithContext(context, replaceTektonPipelinePropertyOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCdTektonPipelinePropertiesPropertyToMap(model *cdtektonpipelinev2.Property) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["name"] = flex.StringValue(model.Name)
+	modelMap["type"] = flex.StringValue(model.Type)
+	modelMap["value"] = flex.StringValue(model.Value)
+	modelMap["enum"] = model.Enum
+	modelMap["locked"] = model.Locked != nil && *model.Locked
+	modelMap["path"] = flex.StringValue(model.Path)
+	return modelMap
+}
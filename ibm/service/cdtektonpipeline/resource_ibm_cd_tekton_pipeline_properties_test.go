@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCdTektonPipelinePropertiesBasic(t *testing.T) {
+	value := fmt.Sprintf("tf_value_%d", acctest.RandIntRange(10, 100))
+	valueUpdate := fmt.Sprintf("tf_value_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelinePropertiesConfigBasic(value, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_properties.cd_tekton_pipeline_properties", "id"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_properties.cd_tekton_pipeline_properties", "property.#", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelinePropertiesConfigBasic(valueUpdate, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_properties.cd_tekton_pipeline_properties", "property.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cd_tekton_pipeline_properties.cd_tekton_pipeline_properties",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"property"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdTektonPipelinePropertiesConfigBasic(value string, withSelect bool) string {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	selectProperty := ""
+	if withSelect {
+		selectProperty = `
+			property {
+				name = "environment"
+				type = "single_select"
+				value = "dev"
+				enum = ["dev", "prod"]
+			}`
+	}
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+		resource "ibm_cd_toolchain_tool_pipeline" "ibm_cd_toolchain_tool_pipeline" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "pipeline-name"
+			}
+		}
+		resource "ibm_cd_tekton_pipeline" "cd_tekton_pipeline" {
+			pipeline_id = ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline.tool_id
+			worker {
+				id = "public"
+			}
+			depends_on = [
+				ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline
+			]
+		}
+		resource "ibm_cd_tekton_pipeline_properties" "cd_tekton_pipeline_properties" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			property {
+				name = "property1"
+				type = "text"
+				value = "%s"
+			}
+			property {
+				name = "secret1"
+				type = "secure"
+				value = "%s"
+				locked = true
+			}
+			%s
+		}
+	`, rgName, tcName, value, value, selectProperty)
+}
@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtektonpipelinev2"
+)
+
+// Statuses of a pipeline run.
+var (
+	pipelineRunPendingStatuses = []string{"pending", "waiting", "queued", "running", "cancelling"}
+	pipelineRunDoneStatuses    = []string{"succeeded", "failed", "cancelled", "error"}
+)
+
+func ResourceIBMCdTektonPipelineRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdTektonPipelineRunCreate,
+		ReadContext:   resourceIBMCdTektonPipelineRunRead,
+		DeleteContext: resourceIBMCdTektonPipelineRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_run", "pipeline_id"),
+				Description:  "The Tekton pipeline ID.",
+			},
+			"trigger_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_tekton_pipeline_run", "trigger_name"),
+				Description:  "Trigger name. The manual trigger of the pipeline to run.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Optional description for the created pipeline run.",
+			},
+			"trigger_properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "An object containing string values only that provides additional `text` properties, or overrides existing pipeline/trigger properties, to use for the created run.",
+			},
+			"secure_trigger_properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "An object containing string values only that provides additional `secure` properties, or overrides existing `secure` pipeline/trigger properties, to use for the created run.",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values which trigger a new run when they change, for example the IDs of the resources to test.",
+			},
+			"wait_for_completion": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether to wait for the run to complete. When false, the run is only triggered.",
+			},
+			"fail_on_error": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the creation fails when the run doesn't succeed. Only used when `wait_for_completion` is true.",
+			},
+			"run_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the pipeline run.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the pipeline run.",
+			},
+			"run_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL for the details page of the pipeline run, including its logs.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API URL for interacting with the pipeline run.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Standard RFC 3339 Date Time String.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Standard RFC 3339 Date Time String.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCdTektonPipelineRunValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "pipeline_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-z]+$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "trigger_name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-zA-Z_., \/]{1,253}$`,
+			MinValueLength:             1,
+			MaxValueLength:             253,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_tekton_pipeline_run", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCdTektonPipelineRunCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createTektonPipelineRunOptions := &cdtektonpipelinev2.CreateTektonPipelineRunOptions{}
+
+	createTektonPipelineRunOptions.SetPipelineID(d.Get("pipeline_id").(string))
+	createTektonPipelineRunOptions.SetTriggerName(d.Get("trigger_name").(string))
+	if _, ok := d.GetOk("description"); ok {
+		createTektonPipelineRunOptions.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("trigger_properties"); ok {
+		createTektonPipelineRunOptions.SetTriggerProperties(d.Get("trigger_properties").(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("secure_trigger_properties"); ok {
+		createTektonPipelineRunOptions.SetSecureTriggerProperties(d.Get("secure_trigger_properties").(map[string]interface{}))
+	}
+
+	pipelineRun, response, err := cdTektonPipelineClient.CreateTektonPipelineRunWithContext(context, createTektonPipelineRunOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelineRunOptions.PipelineID, *pipelineRun.ID))
+
+	if d.Get("wait_for_completion").(bool) {
+		run, err := waitForTektonPipelineRunDone(context, cdTektonPipelineClient, *createTektonPipelineRunOptions.PipelineID, *pipelineRun.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for the pipeline run (%s) to complete: %s", d.Id(), err))
+		}
+		if status := flex.StringValue(run.Status); status != "succeeded" && d.Get("fail_on_error").(bool) {
+			// Keep the failed run in the state so that it is triggered again by
+			// the next apply.
+			d.Set("status", status)
+			d.Set("run_url", run.RunURL)
+			return diag.FromErr(fmt.Errorf("The pipeline run (%s) completed with the status %s, see %s", d.Id(), status, flex.StringValue(run.RunURL)))
+		}
+	}
+
+	return resourceIBMCdTektonPipelineRunRead(context, d, meta)
+}
+
+func resourceIBMCdTektonPipelineRunRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getTektonPipelineRunOptions := &cdtektonpipelinev2.GetTektonPipelineRunOptions{}
+
+	getTektonPipelineRunOptions.SetPipelineID(parts[0])
+	getTektonPipelineRunOptions.SetID(parts[1])
+
+	pipelineRun, response, err := cdTektonPipelineClient.GetTektonPipelineRunWithContext(context, getTektonPipelineRunOptions)
+	if err != nil {
+		// The runs are pruned from the history of the pipeline after a while.
+		// The pruned run is kept in the state, so that a new run is only
+		// triggered when the arguments of the resource change.
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] The pipeline run (%s) was pruned from the history of the pipeline, keeping its last known state", d.Id())
+			return nil
+		}
+		log.Printf("[DEBUG] GetTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("pipeline_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pipeline_id: %s", err))
+	}
+	if err = d.Set("run_id", pipelineRun.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting run_id: %s", err))
+	}
+	if err = d.Set("status", pipelineRun.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("run_url", pipelineRun.RunURL); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting run_url: %s", err))
+	}
+	if err = d.Set("href", pipelineRun.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(pipelineRun.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(pipelineRun.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+// resourceIBMCdTektonPipelineRunDelete cancels the run if it is still in
+// progress. The run is kept in the history of the pipeline.
+func resourceIBMCdTektonPipelineRunDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getTektonPipelineRunOptions := &cdtektonpipelinev2.GetTektonPipelineRunOptions{}
+	getTektonPipelineRunOptions.SetPipelineID(parts[0])
+	getTektonPipelineRunOptions.SetID(parts[1])
+
+	pipelineRun, response, err := cdTektonPipelineClient.GetTektonPipelineRunWithContext(context, getTektonPipelineRunOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetTektonPipelineRunWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetTektonPipelineRunWithContext failed %s\n%s", err, response))
+	}
+
+	if isPipelineRunPending(flex.StringValue(pipelineRun.Status)) {
+		cancelTektonPipelineRunOptions := &cdtektonpipelinev2.CancelTektonPipelineRunOptions{}
+		cancelTektonPipelineRunOptions.SetPipelineID(parts[0])
+		cancelTektonPipelineRunOptions.SetID(parts[1])
+
+		_, response, err := cdTektonPipelineClient.CancelTektonPipelineRunWithContext(context, cancelTektonPipelineRunOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CancelTektonPipelineRunWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CancelTektonPipelineRunWithContext failed %s\n%s", err, response))
+		}
+		_, err = waitForTektonPipelineRunDone(context, cdTektonPipelineClient, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for the pipeline run (%s) to be cancelled: %s", d.Id(), err))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func waitForTektonPipelineRunDone(context context.Context, cdTektonPipelineClient *cdtektonpipelinev2.CdTektonPipelineV2, pipelineID, runID string, timeout time.Duration) (*cdtektonpipelinev2.PipelineRun, error) {
+	getTektonPipelineRunOptions := &cdtektonpipelinev2.GetTektonPipelineRunOptions{}
+	getTektonPipelineRunOptions.SetPipelineID(pipelineID)
+	getTektonPipelineRunOptions.SetID(runID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pipelineRunPendingStatuses,
+		Target:  pipelineRunDoneStatuses,
+		Refresh: func() (interface{}, string, error) {
+			pipelineRun, response, err := cdTektonPipelineClient.GetTektonPipelineRunWithContext(context, getTektonPipelineRunOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetTektonPipelineRunWithContext failed %s\n%s", err, response)
+			}
+			log.Printf("[DEBUG] The pipeline run %s/%s is %s", pipelineID, runID, flex.StringValue(pipelineRun.Status))
+			return pipelineRun, flex.StringValue(pipelineRun.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	pipelineRun, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return pipelineRun.(*cdtektonpipelinev2.PipelineRun), nil
+}
+
+func isPipelineRunPending(status string) bool {
+	for _, pending := range pipelineRunPendingStatuses {
+		if status == pending {
+			return true
+		}
+	}
+	return false
+}
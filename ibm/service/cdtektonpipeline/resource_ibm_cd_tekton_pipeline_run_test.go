@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtektonpipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCdTektonPipelineRunBasic(t *testing.T) {
+	triggerName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineRunConfigBasic(triggerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_run.cd_tekton_pipeline_run", "id"),
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_run.cd_tekton_pipeline_run", "run_id"),
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_run.cd_tekton_pipeline_run", "run_url"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_run.cd_tekton_pipeline_run", "status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_run.cd_tekton_pipeline_run", "trigger_name", triggerName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdTektonPipelineRunConfigBasic(triggerName string) string {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+		resource "ibm_cd_toolchain_tool_pipeline" "ibm_cd_toolchain_tool_pipeline" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "pipeline-name"
+			}
+		}
+		resource "ibm_cd_tekton_pipeline" "cd_tekton_pipeline" {
+			pipeline_id = ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline.tool_id
+			worker {
+				id = "public"
+			}
+			depends_on = [
+				ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline
+			]
+		}
+		resource "ibm_cd_toolchain_tool_githubconsolidated" "definition-repo" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			name = "definition-repo"
+			initialization {
+				type = "link"
+				repo_url = "https://github.com/open-toolchain/hello-tekton.git"
+			}
+			parameters {}
+		}
+		resource "ibm_cd_tekton_pipeline_definition" "cd_tekton_pipeline_definition" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			source {
+				type = "git"
+				properties {
+					url = "https://github.com/open-toolchain/hello-tekton.git"
+					branch = "master"
+					path = ".tekton"
+				}
+			}
+			depends_on = [
+				ibm_cd_tekton_pipeline.cd_tekton_pipeline
+			]
+		}
+		resource "ibm_cd_tekton_pipeline_trigger" "cd_tekton_pipeline_trigger" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			depends_on = [
+				ibm_cd_tekton_pipeline_definition.cd_tekton_pipeline_definition
+			]
+			type = "manual"
+			name = "%s"
+			event_listener = "listener"
+		}
+		resource "ibm_cd_tekton_pipeline_run" "cd_tekton_pipeline_run" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			trigger_name = ibm_cd_tekton_pipeline_trigger.cd_tekton_pipeline_trigger.name
+			description = "Run triggered by Terraform"
+			trigger_properties = {
+				"repository" = "https://github.com/open-toolchain/hello-tekton.git"
+			}
+		}
+	`, rgName, tcName, triggerName)
+}
@@ -5,6 +5,8 @@ package cdtektonpipeline
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"sort"
@@ -26,6 +28,7 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 		UpdateContext: resourceIBMCdTektonPipelineTriggerUpdate,
 		DeleteContext: resourceIBMCdTektonPipelineTriggerDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCdTektonPipelineTriggerCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"pipeline_id": &schema.Schema{
@@ -201,7 +204,7 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 						"value": &schema.Schema{
 							Type:             schema.TypeString,
 							Optional:         true,
-							DiffSuppressFunc: flex.SuppressGenericWebhookRawSecret,
+							DiffSuppressFunc: suppressGeneratedWebhookSecret,
 							Description:      "Secret value, not needed if secret type is `internal_validation`.",
 						},
 						"source": &schema.Schema{
@@ -222,6 +225,18 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 					},
 				},
 			},
+			"rotate_secret": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only used for generic webhook triggers. Arbitrary values which generate a new secret value when they change, the generated value is exposed in `generated_secret`. Leave `secret.value` empty when setting it.",
+			},
+			"generated_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret value generated because of `rotate_secret`.",
+			},
 			"href": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -387,7 +402,17 @@ func resourceIBMCdTektonPipelineTriggerCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if _, ok := d.GetOk("rotate_secret"); ok {
+			secretValue, err := generateWebhookSecret()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			secretModel.Value = &secretValue
+			d.Set("generated_secret", secretValue)
+		}
 		createTektonPipelineTriggerOptions.SetSecret(secretModel)
+	} else if _, ok := d.GetOk("rotate_secret"); ok {
+		return diag.FromErr(fmt.Errorf("The secret block is required to generate a secret with rotate_secret"))
 	}
 	if _, ok := d.GetOk("cron"); ok {
 		createTektonPipelineTriggerOptions.SetCron(d.Get("cron").(string))
@@ -630,11 +655,24 @@ func resourceIBMCdTektonPipelineTriggerUpdate(context context.Context, d *schema
 		patchVals.Enabled = &newEnabled
 		hasChange = true
 	}
-	if d.HasChange("secret") {
+	if d.HasChange("secret") || d.HasChange("rotate_secret") {
 		secret, err := resourceIBMCdTektonPipelineTriggerMapToGenericSecret(d.Get("secret.0").(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if _, ok := d.GetOk("rotate_secret"); ok {
+			secretValue := d.Get("generated_secret").(string)
+			if d.HasChange("rotate_secret") || secretValue == "" {
+				secretValue, err = generateWebhookSecret()
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.Set("generated_secret", secretValue)
+			}
+			secret.Value = &secretValue
+		} else {
+			d.Set("generated_secret", "")
+		}
 		patchVals.Secret = secret
 		hasChange = true
 	}
@@ -712,6 +750,33 @@ func resourceIBMCdTektonPipelineTriggerMapToWorkerIdentity(modelMap map[string]i
 	return model, nil
 }
 
+// resourceIBMCdTektonPipelineTriggerCustomizeDiff plans a new generated_secret
+// when rotate_secret changes, so that the resources referencing it are updated
+// in the same apply.
+func resourceIBMCdTektonPipelineTriggerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("rotate_secret") {
+		return diff.SetNewComputed("generated_secret")
+	}
+	return nil
+}
+
+// suppressGeneratedWebhookSecret ignores the empty secret value when the value
+// is generated by rotate_secret.
+func suppressGeneratedWebhookSecret(k, old, new string, d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("rotate_secret"); ok && new == "" {
+		return true
+	}
+	return flex.SuppressGenericWebhookRawSecret(k, old, new, d)
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("Error generating the webhook secret: %s", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
 func resourceIBMCdTektonPipelineTriggerMapToGenericSecret(modelMap map[string]interface{}) (*cdtektonpipelinev2.GenericSecret, error) {
 	model := &cdtektonpipelinev2.GenericSecret{}
 	if modelMap["type"] != nil && modelMap["type"].(string) != "" {
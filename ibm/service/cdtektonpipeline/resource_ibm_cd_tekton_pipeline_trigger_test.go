@@ -292,6 +292,108 @@ func testAccCheckIBMCdTektonPipelineTriggerConfig(pipelineID string, typeVar str
 	`, rgName, tcName, name, maxConcurrentRuns, enabled, favorite, cron, timezone)
 }
 
+func TestAccIBMCdTektonPipelineTriggerRotateSecret(t *testing.T) {
+	var generatedSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCdTektonPipelineTriggerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineTriggerConfigRotateSecret("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cd_tekton_pipeline_trigger.cd_tekton_pipeline_trigger", "generated_secret"),
+					resource.TestCheckResourceAttrWith("ibm_cd_tekton_pipeline_trigger.cd_tekton_pipeline_trigger", "generated_secret", func(value string) error {
+						generatedSecret = value
+						return nil
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelineTriggerConfigRotateSecret("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_cd_tekton_pipeline_trigger.cd_tekton_pipeline_trigger", "generated_secret", func(value string) error {
+						if value == generatedSecret {
+							return fmt.Errorf("The secret wasn't rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdTektonPipelineTriggerConfigRotateSecret(rotation string) string {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+		resource "ibm_cd_toolchain_tool_pipeline" "ibm_cd_toolchain_tool_pipeline" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "pipeline-name"
+			}
+		}
+		resource "ibm_cd_tekton_pipeline" "cd_tekton_pipeline" {
+			pipeline_id = ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline.tool_id
+			worker {
+				id = "public"
+			}
+			depends_on = [
+				ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline
+			]
+		}
+		resource "ibm_cd_toolchain_tool_githubconsolidated" "definition-repo" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			name = "definition-repo"
+			initialization {
+				type = "link"
+				repo_url = "https://github.com/open-toolchain/hello-tekton.git"
+			}
+			parameters {}
+		}
+		resource "ibm_cd_tekton_pipeline_definition" "cd_tekton_pipeline_definition" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			source {
+				type = "git"
+				properties {
+					url = "https://github.com/open-toolchain/hello-tekton.git"
+					branch = "master"
+					path = ".tekton"
+				}
+			}
+			depends_on = [
+				ibm_cd_tekton_pipeline.cd_tekton_pipeline
+			]
+		}
+		resource "ibm_cd_tekton_pipeline_trigger" "cd_tekton_pipeline_trigger" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			depends_on = [
+				ibm_cd_tekton_pipeline_definition.cd_tekton_pipeline_definition
+			]
+			type = "generic"
+			name = "generic1"
+			event_listener = "listener"
+			secret {
+				type = "token_matches"
+				source = "header"
+				key_name = "token"
+			}
+			rotate_secret = {
+				rotation = "%s"
+			}
+		}
+	`, rgName, tcName, rotation)
+}
+
 func testAccCheckIBMCdTektonPipelineTriggerExists(n string, obj cdtektonpipelinev2.Trigger) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -104,7 +104,7 @@ func dataSourceIBMCdToolchainRead(context context.Context, d *schema.ResourceDat
 	toolchain, response, err := cdToolchainClient.GetToolchainByIDWithContext(context, getToolchainByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolchainByIDWithContext failed", "ibm_cd_toolchain", "read").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getToolchainByIDOptions.ToolchainID))
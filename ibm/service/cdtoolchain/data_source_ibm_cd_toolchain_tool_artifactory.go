@@ -163,7 +163,7 @@ func dataSourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *sch
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_artifactory", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "artifactory" {
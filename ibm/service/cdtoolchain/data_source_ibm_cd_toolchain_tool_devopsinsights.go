@@ -103,7 +103,7 @@ func dataSourceIBMCdToolchainToolDevopsinsightsRead(context context.Context, d *
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_devopsinsights", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "draservicebroker" {
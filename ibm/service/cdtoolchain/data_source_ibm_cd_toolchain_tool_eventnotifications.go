@@ -91,6 +91,11 @@ func DataSourceIBMCdToolchainToolEventnotifications() *schema.Resource {
 							Computed:    true,
 							Description: "The name used to identify this tool integration.",
 						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Describes the purpose of this tool integration, it is shown in the Event Notifications instance as the description of the toolchain source.",
+						},
 						"instance_crn": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -122,7 +127,7 @@ func dataSourceIBMCdToolchainToolEventnotificationsRead(context context.Context,
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_eventnotifications", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "eventnotifications" {
@@ -101,6 +101,7 @@ func testAccCheckIBMCdToolchainToolEventnotificationsDataSourceConfigBasic(tcNam
 			parameters {
 				name = "en_tool_01"
 				instance_crn = data.ibm_resource_instance.en_resource_instance.crn
+				description = "description"
 			}
 			depends_on = [
 				ibm_iam_authorization_policy.s2sAuth1
@@ -142,6 +143,7 @@ func testAccCheckIBMCdToolchainToolEventnotificationsDataSourceConfig(tcName str
 			parameters {
 				name = "en_tool_01"
 				instance_crn = data.ibm_resource_instance.en_resource_instance.crn
+				description = "description"
 			}
 			name = "%s"
 			depends_on = [
@@ -131,6 +131,11 @@ func DataSourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 							Computed:    true,
 							Description: "The URL of the GitHub repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"clone_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HTTPS URL used to clone the GitHub repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -213,7 +218,7 @@ func dataSourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context,
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_githubconsolidated", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "githubconsolidated" {
@@ -264,6 +269,7 @@ func dataSourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context,
 			"toolchain_issues_enabled": "has_issues",
 		}
 		modelMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+		SetGitCloneURL(modelMap)
 		parameters = append(parameters, modelMap)
 	}
 	if err = d.Set("parameters", parameters); err != nil {
@@ -131,6 +131,11 @@ func DataSourceIBMCdToolchainToolGitlab() *schema.Resource {
 							Computed:    true,
 							Description: "The URL of the GitLab repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"clone_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HTTPS URL used to clone the GitLab repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -208,7 +213,7 @@ func dataSourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.R
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_gitlab", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "gitlab" {
@@ -259,6 +264,7 @@ func dataSourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.R
 			"toolchain_issues_enabled": "has_issues",
 		}
 		modelMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolGitlab(), remapFields)
+		SetGitCloneURL(modelMap)
 		parameters = append(parameters, modelMap)
 	}
 	if err = d.Set("parameters", parameters); err != nil {
@@ -129,6 +129,11 @@ func DataSourceIBMCdToolchainToolHashicorpvault() *schema.Resource {
 							Computed:    true,
 							Description: "The mount path where your secrets are stored in your HashiCorp Vault instance.",
 						},
+						"namespace": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HashiCorp Vault Enterprise namespace from which secrets are retrieved.",
+						},
 						"secret_filter": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -176,7 +181,7 @@ func dataSourceIBMCdToolchainToolHashicorpvaultRead(context context.Context, d *
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_hashicorpvault", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "hashicorpvault" {
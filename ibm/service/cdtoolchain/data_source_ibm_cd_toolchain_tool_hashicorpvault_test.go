@@ -93,6 +93,7 @@ func testAccCheckIBMCdToolchainToolHashicorpvaultDataSourceConfigBasic(tcName st
 				secret_id = "<secret_id>"
 				dashboard_url = "https://hcv.mycompany.example.com:8200/ui"
 				path = "generic/project/test_project"
+				namespace = "namespace"
 				secret_filter = "secret_filter"
 				default_secret = "default_secret"
 				username = "username"
@@ -129,6 +130,7 @@ func testAccCheckIBMCdToolchainToolHashicorpvaultDataSourceConfig(tcName string,
 				secret_id = "<secret_id>"
 				dashboard_url = "https://hcv.mycompany.example.com:8200/ui"
 				path = "generic/project/test_project"
+				namespace = "namespace"
 				secret_filter = "secret_filter"
 				default_secret = "default_secret"
 				username = "username"
@@ -193,7 +193,7 @@ func dataSourceIBMCdToolchainToolHostedgitRead(context context.Context, d *schem
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_hostedgit", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "hostedgit" {
@@ -138,7 +138,7 @@ func dataSourceIBMCdToolchainToolJiraRead(context context.Context, d *schema.Res
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_jira", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "jira" {
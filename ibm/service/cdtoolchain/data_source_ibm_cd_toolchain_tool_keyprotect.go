@@ -132,7 +132,7 @@ func dataSourceIBMCdToolchainToolKeyprotectRead(context context.Context, d *sche
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_keyprotect", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "keyprotect" {
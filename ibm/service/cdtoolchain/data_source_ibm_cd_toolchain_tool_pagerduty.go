@@ -128,7 +128,7 @@ func dataSourceIBMCdToolchainToolPagerdutyRead(context context.Context, d *schem
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_pagerduty", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "pagerduty" {
@@ -117,7 +117,7 @@ func dataSourceIBMCdToolchainToolPipelineRead(context context.Context, d *schema
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_pipeline", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "pipeline" {
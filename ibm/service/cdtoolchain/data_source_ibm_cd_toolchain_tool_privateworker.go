@@ -128,7 +128,7 @@ func dataSourceIBMCdToolchainToolPrivateworkerRead(context context.Context, d *s
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_privateworker", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "private_worker" {
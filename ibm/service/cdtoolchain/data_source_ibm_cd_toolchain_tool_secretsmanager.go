@@ -142,7 +142,7 @@ func dataSourceIBMCdToolchainToolSecretsmanagerRead(context context.Context, d *
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_secretsmanager", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "secretsmanager" {
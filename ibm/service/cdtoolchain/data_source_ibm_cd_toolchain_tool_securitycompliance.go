@@ -158,7 +158,7 @@ func dataSourceIBMCdToolchainToolSecuritycomplianceRead(context context.Context,
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_securitycompliance", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "security_compliance" {
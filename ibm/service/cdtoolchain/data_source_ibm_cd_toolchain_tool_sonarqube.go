@@ -102,6 +102,22 @@ func DataSourceIBMCdToolchainToolSonarqube() *schema.Resource {
 							Sensitive:   true,
 							Description: "The password or token for authenticating to the SonarQube server. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
 						},
+						"user_token": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The user token for authenticating to the SonarQube server, used instead of `user_login` and `user_password`. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
+						},
+						"project_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key of the SonarQube project bound to the tool integration.",
+						},
+						"insecure_skip_verify": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "When set to true, the TLS certificate of the SonarQube server is not verified. Set this to true if the SonarQube server uses a self-signed certificate.",
+						},
 						"blind_connection": &schema.Schema{
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -138,7 +154,7 @@ func dataSourceIBMCdToolchainToolSonarqubeRead(context context.Context, d *schem
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_sonarqube", "read").GetDiag()
 	}
 
 	if *toolchainTool.ToolTypeID != "sonarqube" {
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+)
+
+func DataSourceIBMCdToolchainTools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCdToolchainToolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"toolchain_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the toolchain.",
+			},
+			"tool_type_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the tools of this type, for example `githubconsolidated`.",
+			},
+			"import_blocks": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Terraform `import` blocks for the toolchain, the listed tools which have a resource type and the Tekton pipelines, to bring an existing toolchain under management in one operation.",
+			},
+			"tools": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tools bound to the toolchain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tool ID.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tool name.",
+						},
+						"tool_type_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique name of the provisioned tool.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current configuration state of the tool.",
+						},
+						"parameters": &schema.Schema{
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Unique key-value pairs representing parameters of the tool. Values which aren't strings, booleans or numbers are JSON encoded.",
+						},
+						"resource_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource group where the tool is located.",
+						},
+						"crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tool CRN.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URI representing the tool.",
+						},
+						"ui_href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URI representing the tool through the UI.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Latest tool update timestamp.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCdToolchainToolsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	toolchainID := d.Get("toolchain_id").(string)
+	listToolsOptions := &cdtoolchainv2.ListToolsOptions{}
+	listToolsOptions.SetToolchainID(toolchainID)
+
+	var pager *cdtoolchainv2.ToolsPager
+	pager, err = cdToolchainClient.NewToolsPager(listToolsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] ToolsPager.GetAll() failed %s", err)
+		return flex.SDKErrorf(err, nil, "ToolsPager.GetAll() failed", "(Data) ibm_cd_toolchain_tools", "read").GetDiag()
+	}
+
+	toolTypeID := d.Get("tool_type_id").(string)
+	mapSlice := []map[string]interface{}{}
+	tools := []cdtoolchainv2.ToolModel{}
+	for _, modelItem := range allItems {
+		if toolTypeID != "" && flex.StringValue(modelItem.ToolTypeID) != toolTypeID {
+			continue
+		}
+		mapSlice = append(mapSlice, dataSourceIBMCdToolchainToolsToolModelToMap(&modelItem))
+		tools = append(tools, modelItem)
+	}
+
+	d.SetId(toolchainID)
+	if err = d.Set("tools", mapSlice); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting tools %s", err))
+	}
+	if err = d.Set("import_blocks", dataSourceIBMCdToolchainToolsImportBlocks(toolchainID, tools)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting import_blocks %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMCdToolchainToolsToolModelToMap(model *cdtoolchainv2.ToolModel) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["id"] = model.ID
+	modelMap["name"] = model.Name
+	modelMap["tool_type_id"] = model.ToolTypeID
+	modelMap["state"] = model.State
+	modelMap["parameters"] = FlattenToolParameters(model.Parameters)
+	modelMap["resource_group_id"] = model.ResourceGroupID
+	modelMap["crn"] = model.CRN
+	modelMap["href"] = model.Href
+	if model.Referent != nil {
+		modelMap["ui_href"] = model.Referent.UIHref
+	}
+	modelMap["updated_at"] = flex.DateTimeToString(model.UpdatedAt)
+	return modelMap
+}
+
+// toolResourceTypes maps the tool type IDs to the Terraform resource types
+// managing them.
+var toolResourceTypes = map[string]string{
+	"appconfig":           "ibm_cd_toolchain_tool_appconfig",
+	"artifactory":         "ibm_cd_toolchain_tool_artifactory",
+	"bitbucketgit":        "ibm_cd_toolchain_tool_bitbucketgit",
+	"customtool":          "ibm_cd_toolchain_tool_custom",
+	"draservicebroker":    "ibm_cd_toolchain_tool_devopsinsights",
+	"eventnotifications":  "ibm_cd_toolchain_tool_eventnotifications",
+	"githubconsolidated":  "ibm_cd_toolchain_tool_githubconsolidated",
+	"gitlab":              "ibm_cd_toolchain_tool_gitlab",
+	"hashicorpvault":      "ibm_cd_toolchain_tool_hashicorpvault",
+	"hostedgit":           "ibm_cd_toolchain_tool_hostedgit",
+	"jenkins":             "ibm_cd_toolchain_tool_jenkins",
+	"jira":                "ibm_cd_toolchain_tool_jira",
+	"keyprotect":          "ibm_cd_toolchain_tool_keyprotect",
+	"nexus":               "ibm_cd_toolchain_tool_nexus",
+	"pagerduty":           "ibm_cd_toolchain_tool_pagerduty",
+	"pipeline":            "ibm_cd_toolchain_tool_pipeline",
+	"private_worker":      "ibm_cd_toolchain_tool_privateworker",
+	"saucelabs":           "ibm_cd_toolchain_tool_saucelabs",
+	"secretsmanager":      "ibm_cd_toolchain_tool_secretsmanager",
+	"security_compliance": "ibm_cd_toolchain_tool_securitycompliance",
+	"slack":               "ibm_cd_toolchain_tool_slack",
+	"sonarqube":           "ibm_cd_toolchain_tool_sonarqube",
+}
+
+var importBlockNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// dataSourceIBMCdToolchainToolsImportBlocks returns the import blocks of the
+// toolchain and its tools, named after the tools and in listing order. Tekton
+// pipeline tools also get an import block for their ibm_cd_tekton_pipeline.
+func dataSourceIBMCdToolchainToolsImportBlocks(toolchainID string, tools []cdtoolchainv2.ToolModel) string {
+	var blocks strings.Builder
+	importBlock := func(resourceType, name, id string) {
+		fmt.Fprintf(&blocks, "import {\n  to = %s.%s\n  id = %q\n}\n\n", resourceType, name, id)
+	}
+
+	importBlock("ibm_cd_toolchain", "cd_toolchain", toolchainID)
+	names := map[string]bool{}
+	for _, tool := range tools {
+		toolID := flex.StringValue(tool.ID)
+		resourceType, ok := toolResourceTypes[flex.StringValue(tool.ToolTypeID)]
+		if !ok {
+			fmt.Fprintf(&blocks, "# Tool %s of type %s has no resource type.\n\n", toolID, flex.StringValue(tool.ToolTypeID))
+			continue
+		}
+
+		name := strings.ToLower(strings.Trim(importBlockNameInvalidChars.ReplaceAllString(flex.StringValue(tool.Name), "_"), "_"))
+		if name == "" || (name[0] >= '0' && name[0] <= '9') {
+			name = strings.TrimSuffix(strings.TrimPrefix(resourceType, "ibm_")+"_"+name, "_")
+		}
+		for base, i := name, 2; names[name]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+		names[name] = true
+
+		importBlock(resourceType, name, fmt.Sprintf("%s/%s", toolchainID, toolID))
+		if resourceType == "ibm_cd_toolchain_tool_pipeline" && tool.Parameters["type"] == "tekton" {
+			importBlock("ibm_cd_tekton_pipeline", name, toolID)
+		}
+	}
+	return strings.TrimSuffix(blocks.String(), "\n")
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCdToolchainToolsDataSourceBasic(t *testing.T) {
+	tcName := fmt.Sprintf("tf_tc_ds_name_%d", acctest.RandIntRange(10, 100))
+	rgName := acc.CdResourceGroupName
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainToolsDataSourceConfigBasic(tcName, rgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "tools.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "tools.0.tool_type_id", "customtool"),
+					resource.TestCheckResourceAttr("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "tools.0.parameters.lifecyclePhase", "DELIVER"),
+					resource.TestCheckResourceAttrSet("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "tools.0.state"),
+					resource.TestMatchResourceAttr("data.ibm_cd_toolchain_tools.cd_toolchain_tools", "import_blocks", regexp.MustCompile(`to = ibm_cd_toolchain_tool_custom\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdToolchainToolsDataSourceConfigBasic(tcName string, rgName string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+
+		resource "ibm_cd_toolchain_tool" "cd_toolchain_tool" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			tool_type_id = "customtool"
+			parameters = {
+				type = "Delivery Pipeline"
+				lifecyclePhase = "DELIVER"
+				name = "My Build and Deploy Pipeline"
+				dashboard_url = "https://cloud.ibm.com/devops/pipelines"
+			}
+		}
+
+		data "ibm_cd_toolchain_tools" "cd_toolchain_tools" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			depends_on = [
+				ibm_cd_toolchain_tool.cd_toolchain_tool
+			]
+		}
+	`, rgName, tcName)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,7 +30,7 @@ func ResourceIBMCdToolchain() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -168,13 +167,12 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 	toolchainPost, response, err := cdToolchainClient.CreateToolchainWithContext(context, createToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolchainWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolchainWithContext failed", "ibm_cd_toolchain", "create").GetDiag()
 	}
 
 	d.SetId(*toolchainPost.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTags(d, "tags", meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *toolchainPost.CRN)
 		if err != nil {
@@ -217,7 +215,7 @@ func resourceIBMCdToolchainRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolchainByIDWithContext failed", "ibm_cd_toolchain", "read").GetDiag()
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *toolchain.CRN)
@@ -304,7 +302,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := cdToolchainClient.UpdateToolchainWithContext(context, updateToolchainOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolchainWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolchainWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolchainWithContext failed", "ibm_cd_toolchain", "update").GetDiag()
 		}
 	}
 
@@ -324,7 +322,7 @@ func resourceIBMCdToolchainDelete(context context.Context, d *schema.ResourceDat
 	response, err := cdToolchainClient.DeleteToolchainWithContext(context, deleteToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolchainWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolchainWithContext failed", "ibm_cd_toolchain", "delete").GetDiag()
 	}
 
 	d.SetId("")
@@ -0,0 +1,386 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCdToolchainTool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdToolchainToolCreate,
+		ReadContext:   resourceIBMCdToolchainToolRead,
+		UpdateContext: resourceIBMCdToolchainToolUpdate,
+		DeleteContext: resourceIBMCdToolchainToolDelete,
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: customdiff.Sequence(
+			ToolStateCustomizeDiff,
+			ToolParameterTypesCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"toolchain_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain_tool", "toolchain_id"),
+				Description:  "ID of the toolchain to bind the tool to.",
+			},
+			"tool_type_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain_tool", "tool_type_id"),
+				Description:  "The unique short name of the tool that should be provisioned, for example `slack` or the name of a third-party tool broker.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain_tool", "name"),
+				Description:  "Name of the tool.",
+			},
+			"parameters": &schema.Schema{
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: flex.SuppressHashedRawSecret,
+				Description:      "Unique key-value pairs representing parameters to be used to create the tool. The values are sent as strings unless their type is set in `parameter_types`. Only the parameters set in the configuration are read back, except on import.",
+			},
+			"parameter_types": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Types of the parameters which aren't strings, keyed by parameter name. The allowable values are `string`, `boolean` and `number`.",
+			},
+			"resource_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource group where the tool is located.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tool CRN.",
+			},
+			"toolchain_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of toolchain which the tool is bound to.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI representing the tool.",
+			},
+			"referent": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information on URIs to access this resource through the UI or API.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ui_href": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URI representing this resource through the UI.",
+						},
+						"api_href": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "URI representing this resource through an API.",
+						},
+					},
+				},
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest tool update timestamp.",
+			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current configuration state of the tool.",
+			},
+			"tool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tool ID.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCdToolchainToolValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "toolchain_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[89abAB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$`,
+			MinValueLength:             36,
+			MaxValueLength:             36,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "tool_type_id",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[-0-9a-zA-Z_]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             100,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([^\x00-\x7F]|[a-zA-Z0-9-._ ])+$`,
+			MinValueLength:             0,
+			MaxValueLength:             128,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_toolchain_tool", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCdToolchainToolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createToolOptions := &cdtoolchainv2.CreateToolOptions{}
+
+	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
+	createToolOptions.SetToolTypeID(d.Get("tool_type_id").(string))
+	if _, ok := d.GetOk("parameters"); ok {
+		parameters, err := ExpandToolParameters(d.Get("parameters").(map[string]interface{}), d.Get("parameter_types").(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createToolOptions.SetParameters(parameters)
+	}
+	if _, ok := d.GetOk("name"); ok {
+		createToolOptions.SetName(d.Get("name").(string))
+	}
+
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool", "create").GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
+
+	return resourceIBMCdToolchainToolRead(context, d, meta)
+}
+
+func resourceIBMCdToolchainToolRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getToolByIDOptions := &cdtoolchainv2.GetToolByIDOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getToolByIDOptions.SetToolchainID(parts[0])
+	getToolByIDOptions.SetToolID(parts[1])
+
+	var toolchainTool *cdtoolchainv2.ToolchainTool
+	var response *core.DetailedResponse
+	err = resource.RetryContext(context, 10*time.Second, func() *resource.RetryError {
+		toolchainTool, response, err = cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
+		if err != nil || toolchainTool == nil {
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		toolchainTool, response, err = cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
+	}
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool", "read").GetDiag()
+	}
+
+	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting toolchain_id: %s", err))
+	}
+	if err = d.Set("tool_type_id", toolchainTool.ToolTypeID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting tool_type_id: %s", err))
+	}
+	if !core.IsNil(toolchainTool.Name) {
+		if err = d.Set("name", toolchainTool.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+		}
+	}
+	// The API returns the default values of the parameters which aren't
+	// configured, only the configured ones are kept unless importing.
+	parameters := FlattenToolParameters(toolchainTool.Parameters)
+	if configured, ok := d.GetOk("parameters"); ok {
+		for key := range parameters {
+			if _, ok := configured.(map[string]interface{})[key]; !ok {
+				delete(parameters, key)
+			}
+		}
+	}
+	if err = d.Set("parameters", parameters); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting parameters: %s", err))
+	}
+	if err = d.Set("resource_group_id", toolchainTool.ResourceGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_group_id: %s", err))
+	}
+	if err = d.Set("crn", toolchainTool.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("toolchain_crn", toolchainTool.ToolchainCRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting toolchain_crn: %s", err))
+	}
+	if err = d.Set("href", toolchainTool.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	referentMap, err := resourceIBMCdToolchainToolToolModelReferentToMap(toolchainTool.Referent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("referent", []map[string]interface{}{referentMap}); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting referent: %s", err))
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(toolchainTool.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+	if err = d.Set("state", toolchainTool.State); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state: %s", err))
+	}
+	if err = d.Set("tool_id", toolchainTool.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting tool_id: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCdToolchainToolUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateToolOptions := &cdtoolchainv2.UpdateToolOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateToolOptions.SetToolchainID(parts[0])
+	updateToolOptions.SetToolID(parts[1])
+
+	hasChange := false
+
+	patchVals := &cdtoolchainv2.ToolchainToolPrototypePatch{}
+	if d.HasChange("toolchain_id") {
+		return diag.FromErr(fmt.Errorf("Cannot update resource property \"%s\" with the ForceNew annotation."+
+			" The resource must be re-created to update this property.", "toolchain_id"))
+	}
+	if d.HasChange("name") {
+		newName := d.Get("name").(string)
+		patchVals.Name = &newName
+		hasChange = true
+	}
+	if d.HasChange("parameters") || d.HasChange("parameter_types") {
+		oldParameters, newParameters := d.GetChange("parameters")
+		parameters, err := ExpandToolParameters(newParameters.(map[string]interface{}), d.Get("parameter_types").(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Removed parameters are reset to their default value.
+		for key := range oldParameters.(map[string]interface{}) {
+			if _, ok := parameters[key]; !ok {
+				parameters[key] = nil
+			}
+		}
+		patchVals.Parameters = parameters
+		hasChange = true
+	}
+
+	if hasChange {
+		updateToolOptions.ToolchainToolPrototypePatch, _ = patchVals.AsPatch()
+		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool", "update").GetDiag()
+		}
+	}
+
+	return resourceIBMCdToolchainToolRead(context, d, meta)
+}
+
+func resourceIBMCdToolchainToolDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteToolOptions := &cdtoolchainv2.DeleteToolOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteToolOptions.SetToolchainID(parts[0])
+	deleteToolOptions.SetToolID(parts[1])
+
+	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool", "delete").GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMCdToolchainToolToolModelReferentToMap(model *cdtoolchainv2.ToolModelReferent) (map[string]interface{}, error) {
+	modelMap := make(map[string]interface{})
+	if model.UIHref != nil {
+		modelMap["ui_href"] = model.UIHref
+	}
+	if model.APIHref != nil {
+		modelMap["api_href"] = model.APIHref
+	}
+	return modelMap, nil
+}
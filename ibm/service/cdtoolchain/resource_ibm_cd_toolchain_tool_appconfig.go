@@ -21,12 +21,13 @@ import (
 )
 
 func ResourceIBMCdToolchainToolAppconfig() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithStateUpgrades(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolAppconfigCreate,
 		ReadContext:   resourceIBMCdToolchainToolAppconfigRead,
 		UpdateContext: resourceIBMCdToolchainToolAppconfigUpdate,
 		DeleteContext: resourceIBMCdToolchainToolAppconfigDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -127,6 +128,8 @@ func ResourceIBMCdToolchainToolAppconfig() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -138,7 +141,9 @@ func ResourceIBMCdToolchainToolAppconfig() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	},
+		flex.RenamedAttributes{Block: "parameters", Renames: map[string]string{"resource_group": "resource_group_name"}},
+	)
 }
 
 func ResourceIBMCdToolchainToolAppconfigValidator() *validate.ResourceValidator {
@@ -191,10 +196,10 @@ func resourceIBMCdToolchainToolAppconfigCreate(context context.Context, d *schem
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_appconfig", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -239,7 +244,7 @@ func resourceIBMCdToolchainToolAppconfigRead(context context.Context, d *schema.
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_appconfig", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -339,7 +344,7 @@ func resourceIBMCdToolchainToolAppconfigUpdate(context context.Context, d *schem
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_appconfig", "update").GetDiag()
 		}
 	}
 
@@ -365,7 +370,7 @@ func resourceIBMCdToolchainToolAppconfigDelete(context context.Context, d *schem
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_appconfig", "delete").GetDiag()
 	}
 
 	d.SetId("")
@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolArtifactoryRead,
 		UpdateContext: resourceIBMCdToolchainToolArtifactoryUpdate,
 		DeleteContext: resourceIBMCdToolchainToolArtifactoryDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -74,6 +75,7 @@ func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "The Access token for your Artifactory repository. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
 						},
@@ -149,6 +151,8 @@ func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -206,10 +210,10 @@ func resourceIBMCdToolchainToolArtifactoryCreate(context context.Context, d *sch
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_artifactory", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -254,7 +258,7 @@ func resourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_artifactory", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -340,7 +344,7 @@ func resourceIBMCdToolchainToolArtifactoryUpdate(context context.Context, d *sch
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_artifactory", "update").GetDiag()
 		}
 	}
 
@@ -366,7 +370,7 @@ func resourceIBMCdToolchainToolArtifactoryDelete(context context.Context, d *sch
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_artifactory", "delete").GetDiag()
 	}
 
 	d.SetId("")
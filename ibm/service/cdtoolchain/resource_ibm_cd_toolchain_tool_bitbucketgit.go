@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolBitbucketgit() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolBitbucketgitRead,
 		UpdateContext: resourceIBMCdToolchainToolBitbucketgitUpdate,
 		DeleteContext: resourceIBMCdToolchainToolBitbucketgitDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -223,6 +224,8 @@ func ResourceIBMCdToolchainToolBitbucketgit() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -283,10 +286,10 @@ func resourceIBMCdToolchainToolBitbucketgitCreate(context context.Context, d *sc
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_bitbucketgit", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -331,7 +334,7 @@ func resourceIBMCdToolchainToolBitbucketgitRead(context context.Context, d *sche
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_bitbucketgit", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -423,7 +426,7 @@ func resourceIBMCdToolchainToolBitbucketgitUpdate(context context.Context, d *sc
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_bitbucketgit", "update").GetDiag()
 		}
 	}
 
@@ -449,7 +452,7 @@ func resourceIBMCdToolchainToolBitbucketgitDelete(context context.Context, d *sc
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_bitbucketgit", "delete").GetDiag()
 	}
 
 	d.SetId("")
@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolCustom() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolCustomRead,
 		UpdateContext: resourceIBMCdToolchainToolCustomUpdate,
 		DeleteContext: resourceIBMCdToolchainToolCustomDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -137,6 +138,8 @@ func ResourceIBMCdToolchainToolCustom() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -200,10 +203,10 @@ func resourceIBMCdToolchainToolCustomCreate(context context.Context, d *schema.R
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_custom", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -248,7 +251,7 @@ func resourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_custom", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -346,7 +349,7 @@ func resourceIBMCdToolchainToolCustomUpdate(context context.Context, d *schema.R
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_custom", "update").GetDiag()
 		}
 	}
 
@@ -372,7 +375,7 @@ func resourceIBMCdToolchainToolCustomDelete(context context.Context, d *schema.R
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_custom", "delete").GetDiag()
 	}
 
 	d.SetId("")
@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolDevopsinsights() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolDevopsinsightsRead,
 		UpdateContext: resourceIBMCdToolchainToolDevopsinsightsUpdate,
 		DeleteContext: resourceIBMCdToolchainToolDevopsinsightsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -86,6 +87,8 @@ func ResourceIBMCdToolchainToolDevopsinsights() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -141,10 +144,10 @@ func resourceIBMCdToolchainToolDevopsinsightsCreate(context context.Context, d *
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_devopsinsights", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -189,7 +192,7 @@ func resourceIBMCdToolchainToolDevopsinsightsRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_devopsinsights", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -266,7 +269,7 @@ func resourceIBMCdToolchainToolDevopsinsightsUpdate(context context.Context, d *
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_devopsinsights", "update").GetDiag()
 		}
 	}
 
@@ -292,7 +295,7 @@ func resourceIBMCdToolchainToolDevopsinsightsDelete(context context.Context, d *
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_devopsinsights", "delete").GetDiag()
 	}
 
 	d.SetId("")
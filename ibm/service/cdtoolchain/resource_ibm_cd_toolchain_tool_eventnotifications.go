@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolEventnotifications() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolEventnotificationsRead,
 		UpdateContext: resourceIBMCdToolchainToolEventnotificationsUpdate,
 		DeleteContext: resourceIBMCdToolchainToolEventnotificationsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -55,6 +56,11 @@ func ResourceIBMCdToolchainToolEventnotifications() *schema.Resource {
 							Required:    true,
 							Description: "The name used to identify this tool integration.",
 						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Describes the purpose of this tool integration, it is shown in the Event Notifications instance as the description of the toolchain source.",
+						},
 						"instance_crn": &schema.Schema{
 							Type:        schema.TypeString,
 							Required:    true,
@@ -107,6 +113,8 @@ func ResourceIBMCdToolchainToolEventnotifications() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -167,10 +175,10 @@ func resourceIBMCdToolchainToolEventnotificationsCreate(context context.Context,
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_eventnotifications", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -215,7 +223,7 @@ func resourceIBMCdToolchainToolEventnotificationsRead(context context.Context, d
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_eventnotifications", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -307,7 +315,7 @@ func resourceIBMCdToolchainToolEventnotificationsUpdate(context context.Context,
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_eventnotifications", "update").GetDiag()
 		}
 	}
 
@@ -333,7 +341,7 @@ func resourceIBMCdToolchainToolEventnotificationsDelete(context context.Context,
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_eventnotifications", "delete").GetDiag()
 	}
 
 	d.SetId("")
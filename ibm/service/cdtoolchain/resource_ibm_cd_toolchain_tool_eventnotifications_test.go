@@ -104,6 +104,7 @@ func testAccCheckIBMCdToolchainToolEventnotificationsConfigBasic(tcName string,
 			parameters {
 				name = "en_tool_01"
 				instance_crn = data.ibm_resource_instance.en_resource_instance.crn
+				description = "description"
 			}
 			depends_on = [
 				ibm_iam_authorization_policy.s2sAuth1
@@ -140,6 +141,7 @@ func testAccCheckIBMCdToolchainToolEventnotificationsConfig(tcName string, rgNam
 			parameters {
 				name = "en_tool_01"
 				instance_crn = data.ibm_resource_instance.en_resource_instance.crn
+				description = "description"
 			}
 			name = "%s"
 			depends_on = [
@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolGithubconsolidatedRead,
 		UpdateContext: resourceIBMCdToolchainToolGithubconsolidatedUpdate,
 		DeleteContext: resourceIBMCdToolchainToolGithubconsolidatedDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -95,6 +96,11 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 							Computed:    true,
 							Description: "The URL of the GitHub repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"clone_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HTTPS URL used to clone the GitHub repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -146,6 +152,7 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "Personal Access Token. Required if ‘auth_type’ is set to ‘pat’, ignored otherwise.",
 						},
@@ -208,6 +215,18 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 							ForceNew:    true,
 							Description: "The URL of the GitHub repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"default_branch": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The default branch of the new GitHub repository. The default branch of the GitHub server is used when not set.  This parameter is only used when creating a new repository, cloning, or forking a repository.",
+						},
+						"template_repo_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The URL of a template repository whose content is used as the initial commit of the new GitHub repository.  This parameter is only used when creating a new repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -281,6 +300,8 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -341,10 +362,10 @@ func resourceIBMCdToolchainToolGithubconsolidatedCreate(context context.Context,
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_githubconsolidated", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -389,7 +410,7 @@ func resourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context, d
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_githubconsolidated", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -404,6 +425,7 @@ func resourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context, d
 		"toolchain_issues_enabled": "has_issues",
 	}
 	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+	SetGitCloneURL(parametersMap)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting parameters: %s", err))
 	}
@@ -481,7 +503,7 @@ func resourceIBMCdToolchainToolGithubconsolidatedUpdate(context context.Context,
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_githubconsolidated", "update").GetDiag()
 		}
 	}
 
@@ -507,7 +529,7 @@ func resourceIBMCdToolchainToolGithubconsolidatedDelete(context context.Context,
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_githubconsolidated", "delete").GetDiag()
 	}
 
 	d.SetId("")
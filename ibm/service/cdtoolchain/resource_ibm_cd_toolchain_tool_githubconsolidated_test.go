@@ -33,6 +33,8 @@ func TestAccIBMCdToolchainToolGithubconsolidatedBasic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMCdToolchainToolGithubconsolidatedExists("ibm_cd_toolchain_tool_githubconsolidated.cd_toolchain_tool_githubconsolidated", conf),
 					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_githubconsolidated.cd_toolchain_tool_githubconsolidated", "toolchain_id"),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_githubconsolidated.cd_toolchain_tool_githubconsolidated", "parameters.0.repo_url"),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_githubconsolidated.cd_toolchain_tool_githubconsolidated", "parameters.0.clone_url"),
 				),
 			},
 		},
@@ -26,7 +26,8 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 		ReadContext:   resourceIBMCdToolchainToolGitlabRead,
 		UpdateContext: resourceIBMCdToolchainToolGitlabUpdate,
 		DeleteContext: resourceIBMCdToolchainToolGitlabDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -95,6 +96,11 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 							Computed:    true,
 							Description: "The URL of the GitLab repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"clone_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HTTPS URL used to clone the GitLab repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -141,6 +147,7 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "Personal Access Token. Required if ‘auth_type’ is set to ‘pat’, ignored otherwise.",
 						},
@@ -203,6 +210,18 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 							ForceNew:    true,
 							Description: "The URL of the GitLab repository for this tool integration.  This parameter is required when linking to an existing repository.  The value will be computed when creating a new repository, cloning, or forking a repository.",
 						},
+						"default_branch": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The default branch of the new GitLab repository. The default branch of the GitLab server is used when not set.  This parameter is only used when creating a new repository, cloning, or forking a repository.",
+						},
+						"template_repo_url": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The URL of a template repository whose content is used as the initial commit of the new GitLab repository.  This parameter is only used when creating a new repository.",
+						},
 						"source_repo_url": &schema.Schema{
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -269,6 +288,8 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -329,10 +350,10 @@ func resourceIBMCdToolchainToolGitlabCreate(context context.Context, d *schema.R
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_gitlab", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -377,7 +398,7 @@ func resourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_gitlab", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -392,6 +413,7 @@ func resourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.Res
 		"toolchain_issues_enabled": "has_issues",
 	}
 	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolGitlab(), remapFields)
+	SetGitCloneURL(parametersMap)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting parameters: %s", err))
 	}
@@ -469,7 +491,7 @@ func resourceIBMCdToolchainToolGitlabUpdate(context context.Context, d *schema.R
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_gitlab", "update").GetDiag()
 		}
 	}
 
@@ -495,7 +517,7 @@ func resourceIBMCdToolchainToolGitlabDelete(context context.Context, d *schema.R
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_gitlab", "delete").GetDiag()
 	}
 
 	d.SetId("")
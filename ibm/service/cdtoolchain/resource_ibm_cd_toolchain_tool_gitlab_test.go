@@ -33,6 +33,8 @@ func TestAccIBMCdToolchainToolGitlabBasic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMCdToolchainToolGitlabExists("ibm_cd_toolchain_tool_gitlab.cd_toolchain_tool_gitlab", conf),
 					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_gitlab.cd_toolchain_tool_gitlab", "toolchain_id"),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_gitlab.cd_toolchain_tool_gitlab", "parameters.0.repo_url"),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_gitlab.cd_toolchain_tool_gitlab", "parameters.0.clone_url"),
 				),
 			},
 		},
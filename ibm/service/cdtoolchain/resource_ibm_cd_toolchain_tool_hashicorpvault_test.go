@@ -56,6 +56,7 @@ func TestAccIBMCdToolchainToolHashicorpvaultAllArgs(t *testing.T) {
 					testAccCheckIBMCdToolchainToolHashicorpvaultExists("ibm_cd_toolchain_tool_hashicorpvault.cd_toolchain_tool_hashicorpvault", conf),
 					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_tool_hashicorpvault.cd_toolchain_tool_hashicorpvault", "toolchain_id"),
 					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool_hashicorpvault.cd_toolchain_tool_hashicorpvault", "name", name),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool_hashicorpvault.cd_toolchain_tool_hashicorpvault", "parameters.0.namespace", "namespace"),
 				),
 			},
 			resource.TestStep{
@@ -96,6 +97,7 @@ func testAccCheckIBMCdToolchainToolHashicorpvaultConfigBasic(tcName string, rgNa
 				secret_id = "<secret_id>"
 				dashboard_url = "https://hcv.mycompany.example.com:8200/ui"
 				path = "generic/project/test_project"
+				namespace = "namespace"
 				secret_filter = "secret_filter"
 				default_secret = "default_secret"
 				username = "username"
@@ -127,6 +129,7 @@ func testAccCheckIBMCdToolchainToolHashicorpvaultConfig(tcName string, rgName st
 				secret_id = "<secret_id>"
 				dashboard_url = "https://hcv.mycompany.example.com:8200/ui"
 				path = "generic/project/test_project"
+				namespace = "namespace"
 				secret_filter = "secret_filter"
 				default_secret = "default_secret"
 				username = "username"
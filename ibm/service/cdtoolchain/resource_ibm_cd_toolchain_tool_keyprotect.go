@@ -21,12 +21,13 @@ import (
 )
 
 func ResourceIBMCdToolchainToolKeyprotect() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithStateUpgrades(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolKeyprotectCreate,
 		ReadContext:   resourceIBMCdToolchainToolKeyprotectRead,
 		UpdateContext: resourceIBMCdToolchainToolKeyprotectUpdate,
 		DeleteContext: resourceIBMCdToolchainToolKeyprotectDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -117,6 +118,8 @@ func ResourceIBMCdToolchainToolKeyprotect() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -128,7 +131,9 @@ func ResourceIBMCdToolchainToolKeyprotect() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	},
+		flex.RenamedAttributes{Block: "parameters", Renames: map[string]string{"resource_group": "resource_group_name"}},
+	)
 }
 
 func ResourceIBMCdToolchainToolKeyprotectValidator() *validate.ResourceValidator {
@@ -179,10 +184,10 @@ func resourceIBMCdToolchainToolKeyprotectCreate(context context.Context, d *sche
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_keyprotect", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -227,7 +232,7 @@ func resourceIBMCdToolchainToolKeyprotectRead(context context.Context, d *schema
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_keyprotect", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -323,7 +328,7 @@ func resourceIBMCdToolchainToolKeyprotectUpdate(context context.Context, d *sche
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_keyprotect", "update").GetDiag()
 		}
 	}
 
@@ -349,7 +354,7 @@ func resourceIBMCdToolchainToolKeyprotectDelete(context context.Context, d *sche
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_keyprotect", "delete").GetDiag()
 	}
 
 	d.SetId("")
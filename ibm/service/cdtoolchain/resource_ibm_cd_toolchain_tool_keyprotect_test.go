@@ -4,7 +4,9 @@
 package cdtoolchain_test
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -14,6 +16,7 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtoolchain"
 	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
 )
 
@@ -76,6 +79,43 @@ func TestAccIBMCdToolchainToolKeyprotectAllArgs(t *testing.T) {
 	})
 }
 
+func TestIBMCdToolchainToolKeyprotectStateUpgradeV0(t *testing.T) {
+	upgraders := cdtoolchain.ResourceIBMCdToolchainToolKeyprotect().StateUpgraders
+	if len(upgraders) != 1 || upgraders[0].Version != 0 {
+		t.Fatalf("expected a state upgrader of version 0, got %d state upgraders", len(upgraders))
+	}
+
+	state := map[string]interface{}{
+		"toolchain_id": "toolchain-id",
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":           "kp",
+				"location":       "us-south",
+				"resource_group": "default",
+				"instance_name":  "kp-instance",
+			},
+		},
+	}
+	expected := map[string]interface{}{
+		"toolchain_id": "toolchain-id",
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":                "kp",
+				"location":            "us-south",
+				"resource_group_name": "default",
+				"instance_name":       "kp-instance",
+			},
+		},
+	}
+	actual, err := upgraders[0].Upgrade(context.Background(), state, nil)
+	if err != nil {
+		t.Fatalf("error upgrading the state: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the upgraded state %v, got %v", expected, actual)
+	}
+}
+
 func testAccCheckIBMCdToolchainToolKeyprotectConfigBasic(tcName string, rgName string, kpName string) string {
 	return fmt.Sprintf(`
 		data "ibm_resource_group" "resource_group" {
@@ -21,12 +21,13 @@ import (
 )
 
 func ResourceIBMCdToolchainToolSecretsmanager() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithStateUpgrades(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolSecretsmanagerCreate,
 		ReadContext:   resourceIBMCdToolchainToolSecretsmanagerRead,
 		UpdateContext: resourceIBMCdToolchainToolSecretsmanagerUpdate,
 		DeleteContext: resourceIBMCdToolchainToolSecretsmanagerDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      &schema.ResourceImporter{StateContext: ToolImportState},
+		CustomizeDiff: ToolStateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -127,6 +128,8 @@ func ResourceIBMCdToolchainToolSecretsmanager() *schema.Resource {
 				Computed:    true,
 				Description: "Latest tool update timestamp.",
 			},
+			"recreate_on_misconfigured": ToolRecreateOnMisconfiguredSchema(),
+			"fail_on_misconfigured":     ToolFailOnMisconfiguredSchema(),
 			"state": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -138,7 +141,9 @@ func ResourceIBMCdToolchainToolSecretsmanager() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	},
+		flex.RenamedAttributes{Block: "parameters", Renames: map[string]string{"resource_group": "resource_group_name"}},
+	)
 }
 
 func ResourceIBMCdToolchainToolSecretsmanagerValidator() *validate.ResourceValidator {
@@ -164,7 +169,20 @@ func ResourceIBMCdToolchainToolSecretsmanagerValidator() *validate.ResourceValid
 		},
 	)
 
-	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_toolchain_tool_secretsmanager", Schema: validateSchema}
+	crossFieldRules := []validate.CrossFieldRule{
+		{
+			Type:        validate.MutuallyExclusive,
+			Block:       "parameters",
+			Identifiers: []string{"instance_name", "instance_crn"},
+		},
+		{
+			Type:        validate.AtLeastOneOf,
+			Block:       "parameters",
+			Identifiers: []string{"instance_name", "instance_crn"},
+		},
+	}
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cd_toolchain_tool_secretsmanager", Schema: validateSchema, CrossFieldRules: crossFieldRules}
 	return &resourceValidator
 }
 
@@ -191,10 +209,10 @@ func resourceIBMCdToolchainToolSecretsmanagerCreate(context context.Context, d *
 		createToolOptions.SetName(d.Get("name").(string))
 	}
 
-	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	toolchainToolPost, response, err := CreateToolWithRetry(context, cdToolchainClient, createToolOptions, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "CreateToolWithContext failed", "ibm_cd_toolchain_tool_secretsmanager", "create").GetDiag()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -239,7 +257,7 @@ func resourceIBMCdToolchainToolSecretsmanagerRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "GetToolByIDWithContext failed", "ibm_cd_toolchain_tool_secretsmanager", "read").GetDiag()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -339,7 +357,7 @@ func resourceIBMCdToolchainToolSecretsmanagerUpdate(context context.Context, d *
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.SDKErrorf(err, response, "UpdateToolWithContext failed", "ibm_cd_toolchain_tool_secretsmanager", "update").GetDiag()
 		}
 	}
 
@@ -365,7 +383,7 @@ func resourceIBMCdToolchainToolSecretsmanagerDelete(context context.Context, d *
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.SDKErrorf(err, response, "DeleteToolWithContext failed", "ibm_cd_toolchain_tool_secretsmanager", "delete").GetDiag()
 	}
 
 	d.SetId("")
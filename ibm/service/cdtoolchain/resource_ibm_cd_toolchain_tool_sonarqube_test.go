@@ -74,6 +74,28 @@ func TestAccIBMCdToolchainToolSonarqubeAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCdToolchainToolSonarqubeTokenAuth(t *testing.T) {
+	var conf cdtoolchainv2.ToolchainTool
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCdToolchainToolSonarqubeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainToolSonarqubeConfigTokenAuth(tcName, rgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCdToolchainToolSonarqubeExists("ibm_cd_toolchain_tool_sonarqube.cd_toolchain_tool_sonarqube", conf),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool_sonarqube.cd_toolchain_tool_sonarqube", "parameters.0.project_key", "my-project"),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool_sonarqube.cd_toolchain_tool_sonarqube", "parameters.0.insecure_skip_verify", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCdToolchainToolSonarqubeConfigBasic(tcName string, rgName string) string {
 	return fmt.Sprintf(`
 		data "ibm_resource_group" "resource_group" {
@@ -123,6 +145,31 @@ func testAccCheckIBMCdToolchainToolSonarqubeConfig(tcName string, rgName string,
 	`, rgName, tcName, name)
 }
 
+func testAccCheckIBMCdToolchainToolSonarqubeConfigTokenAuth(tcName string, rgName string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+
+		resource "ibm_cd_toolchain_tool_sonarqube" "cd_toolchain_tool_sonarqube" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "my-sonarqube"
+				user_token = "<user_token>"
+				project_key = "my-project"
+				insecure_skip_verify = true
+				blind_connection = true
+				server_url = "https://my.sonarqube.server.com/"
+			}
+		}
+	`, rgName, tcName)
+}
+
 func testAccCheckIBMCdToolchainToolSonarqubeExists(n string, obj cdtoolchainv2.ToolchainTool) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
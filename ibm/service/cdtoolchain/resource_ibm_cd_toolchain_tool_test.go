@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtoolchain"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+)
+
+func TestAccIBMCdToolchainToolBasic(t *testing.T) {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCdToolchainToolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainToolConfig(tcName, rgName, name, "DELIVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCdToolchainToolExists("ibm_cd_toolchain_tool.cd_toolchain_tool"),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool.cd_toolchain_tool", "tool_type_id", "customtool"),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool.cd_toolchain_tool", "name", name),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool.cd_toolchain_tool", "parameters.lifecyclePhase", "DELIVER"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainToolConfig(tcName, rgName, nameUpdate, "BUILD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool.cd_toolchain_tool", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_tool.cd_toolchain_tool", "parameters.lifecyclePhase", "BUILD"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cd_toolchain_tool.cd_toolchain_tool",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdToolchainToolConfig(tcName string, rgName string, name string, lifecyclePhase string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+
+		resource "ibm_cd_toolchain_tool" "cd_toolchain_tool" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			tool_type_id = "customtool"
+			name = "%s"
+			parameters = {
+				type = "Delivery Pipeline"
+				lifecyclePhase = "%s"
+				name = "My Build and Deploy Pipeline"
+				dashboard_url = "https://cloud.ibm.com/devops/pipelines/tekton/ae47390c-9495-4b0b-a489-78464685acdd"
+			}
+		}
+	`, rgName, tcName, name, lifecyclePhase)
+}
+
+func testAccCheckIBMCdToolchainToolExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		cdToolchainClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CdToolchainV2()
+		if err != nil {
+			return err
+		}
+
+		getToolByIDOptions := &cdtoolchainv2.GetToolByIDOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getToolByIDOptions.SetToolchainID(parts[0])
+		getToolByIDOptions.SetToolID(parts[1])
+
+		_, _, err = cdToolchainClient.GetToolByID(getToolByIDOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMCdToolchainToolDestroy(s *terraform.State) error {
+	cdToolchainClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cd_toolchain_tool" {
+			continue
+		}
+
+		getToolByIDOptions := &cdtoolchainv2.GetToolByIDOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getToolByIDOptions.SetToolchainID(parts[0])
+		getToolByIDOptions.SetToolID(parts[1])
+
+		// Try to find the key
+		_, response, err := cdToolchainClient.GetToolByID(getToolByIDOptions)
+
+		if err == nil {
+			return fmt.Errorf("cd_toolchain_tool still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for cd_toolchain_tool (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func TestCdToolchainToolParametersRoundTrip(t *testing.T) {
+	params := map[string]interface{}{
+		"enabled": "true",
+		"port":    "8080",
+		"ratio":   "0.5",
+		"big":     "1000000",
+		"padded":  "007",
+		"flag":    "false",
+		"name":    "my-tool",
+	}
+	types := map[string]interface{}{
+		"enabled": "boolean",
+		"port":    "number",
+		"ratio":   "number",
+		"big":     "number",
+		"name":    "string",
+	}
+	expanded, err := cdtoolchain.ExpandToolParameters(params, types)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expanded["enabled"] != true || expanded["port"] != float64(8080) || expanded["big"] != float64(1000000) {
+		t.Fatalf("expected the typed parameters to be expanded, got %v", expanded)
+	}
+	if expanded["padded"] != "007" || expanded["flag"] != "false" || expanded["name"] != "my-tool" {
+		t.Fatalf("expected the untyped parameters to be kept as strings, got %v", expanded)
+	}
+
+	flattened := cdtoolchain.FlattenToolParameters(expanded)
+	for key, value := range params {
+		if flattened[key] != value {
+			t.Errorf("expected %s to be flattened to %q, got %q", key, value, flattened[key])
+		}
+	}
+}
+
+func TestCdToolchainToolParametersInvalidTypes(t *testing.T) {
+	invalid := []struct {
+		value         string
+		parameterType string
+	}{
+		{"yes", "boolean"},
+		{"007", "number"},
+		{"1e5", "number"},
+		{"value", "list"},
+	}
+	for _, tc := range invalid {
+		params := map[string]interface{}{"key": tc.value}
+		types := map[string]interface{}{"key": tc.parameterType}
+		if _, err := cdtoolchain.ExpandToolParameters(params, types); err == nil {
+			t.Errorf("expected an error for %q of type %s", tc.value, tc.parameterType)
+		}
+	}
+}
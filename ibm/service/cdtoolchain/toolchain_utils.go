@@ -1,9 +1,93 @@
 package cdtoolchain
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
 )
 
+// Configuration state of a tool which integration is broken, for example
+// because its credentials were revoked.
+const toolStateMisconfigured = "misconfigured"
+
+func ToolRecreateOnMisconfiguredSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, the tool is deleted and created again when its state is `misconfigured`.",
+	}
+}
+
+func ToolFailOnMisconfiguredSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, the plan fails when the state of the tool is `misconfigured`.",
+	}
+}
+
+// ToolImportState sets the defaults of the misconfigured state arguments,
+// which aren't read from the tool.
+func ToolImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("recreate_on_misconfigured", false)
+	d.Set("fail_on_misconfigured", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// ToolStateCustomizeDiff acts on the tools found misconfigured by the last
+// refresh, so that broken integrations don't go unnoticed.
+func ToolStateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("state").(string) != toolStateMisconfigured {
+		return nil
+	}
+	if diff.Get("recreate_on_misconfigured").(bool) {
+		if err := diff.SetNewComputed("state"); err != nil {
+			return err
+		}
+		return diff.ForceNew("state")
+	}
+	if diff.Get("fail_on_misconfigured").(bool) {
+		return fmt.Errorf("The tool %s is %s, fix its parameters or set recreate_on_misconfigured to create it again", diff.Id(), toolStateMisconfigured)
+	}
+	return nil
+}
+
+// CreateToolWithRetry creates a tool, retrying until timeout while the
+// toolchain is not found, which happens when the toolchain and the tool are
+// created in the same apply and the toolchain isn't visible to the tools API
+// yet.
+func CreateToolWithRetry(context context.Context, cdToolchainClient *cdtoolchainv2.CdToolchainV2, createToolOptions *cdtoolchainv2.CreateToolOptions, timeout time.Duration) (*cdtoolchainv2.ToolchainToolPost, *core.DetailedResponse, error) {
+	var toolchainToolPost *cdtoolchainv2.ToolchainToolPost
+	var response *core.DetailedResponse
+	var err error
+	err = resource.RetryContext(context, timeout, func() *resource.RetryError {
+		toolchainToolPost, response, err = cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		toolchainToolPost, response, err = cdToolchainClient.CreateToolWithContext(context, createToolOptions)
+	}
+	return toolchainToolPost, response, err
+}
+
 func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
 	params := make(map[string]interface{})
 
@@ -54,6 +138,20 @@ func GetParametersFromRead(readParams map[string]interface{}, resource *schema.R
 	return params
 }
 
+// SetGitCloneURL sets the computed "clone_url" of the parameters of a git tool
+// from its "repo_url", which is the web URL of the repository.
+func SetGitCloneURL(params map[string]interface{}) {
+	repoURL, ok := params["repo_url"].(string)
+	if !ok || repoURL == "" {
+		return
+	}
+	repoURL = strings.TrimSuffix(repoURL, "/")
+	if !strings.HasSuffix(repoURL, ".git") {
+		repoURL += ".git"
+	}
+	params["clone_url"] = repoURL
+}
+
 func getTargetField(field string, remapFields map[string]string) string {
 	if remapFields != nil {
 		if val, ok := remapFields[field]; ok {
@@ -62,3 +160,88 @@ func getTargetField(field string, remapFields map[string]string) string {
 	}
 	return field
 }
+
+// Types of the tool parameters which can be set in the "parameter_types" of
+// the generic tool resource, the parameters without a type are strings.
+const (
+	toolParameterTypeString  = "string"
+	toolParameterTypeBoolean = "boolean"
+	toolParameterTypeNumber  = "number"
+)
+
+// ExpandToolParameters converts the free-form string parameters of a tool to
+// the parameters of the API. The parameters are sent as strings unless their
+// type is set to "boolean" or "number" in types, in which case they must be
+// formatted as FlattenToolParameters does, so that the parameters read back
+// from the API match the configuration.
+func ExpandToolParameters(params map[string]interface{}, types map[string]interface{}) (map[string]interface{}, error) {
+	expanded := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		expanded[key] = value
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		parameterType, _ := types[key].(string)
+		switch parameterType {
+		case "", toolParameterTypeString:
+		case toolParameterTypeBoolean:
+			if str != "true" && str != "false" {
+				return nil, fmt.Errorf("The parameter %s must be true or false, got %q", key, str)
+			}
+			expanded[key] = str == "true"
+		case toolParameterTypeNumber:
+			number, err := strconv.ParseFloat(str, 64)
+			if err != nil || formatToolParameterNumber(number) != str {
+				return nil, fmt.Errorf("The parameter %s must be a plain decimal number such as 8080 or 0.5, got %q", key, str)
+			}
+			expanded[key] = number
+		default:
+			return nil, fmt.Errorf("The type %q of the parameter %s must be one of %s, %s or %s", parameterType, key, toolParameterTypeString, toolParameterTypeBoolean, toolParameterTypeNumber)
+		}
+	}
+	return expanded, nil
+}
+
+// ToolParameterTypesCustomizeDiff checks at plan time that the "parameter_types"
+// of a tool are known and that the parameters they type can be converted.
+func ToolParameterTypesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("parameters") || !diff.NewValueKnown("parameter_types") {
+		return nil
+	}
+	_, err := ExpandToolParameters(diff.Get("parameters").(map[string]interface{}), diff.Get("parameter_types").(map[string]interface{}))
+	return err
+}
+
+// FlattenToolParameters converts the parameters of a tool returned by the API
+// to free-form string parameters. The strings are kept as is, the booleans and
+// numbers are formatted and the other values are JSON encoded.
+func FlattenToolParameters(params map[string]interface{}) map[string]string {
+	flattened := make(map[string]string, len(params))
+	for key, value := range params {
+		switch value := value.(type) {
+		case nil:
+		case string:
+			flattened[key] = value
+		case bool:
+			flattened[key] = strconv.FormatBool(value)
+		case float64:
+			flattened[key] = formatToolParameterNumber(value)
+		case int64:
+			flattened[key] = strconv.FormatInt(value, 10)
+		case json.Number:
+			flattened[key] = value.String()
+		default:
+			if encoded, err := json.Marshal(value); err == nil {
+				flattened[key] = string(encoded)
+			}
+		}
+	}
+	return flattened
+}
+
+// formatToolParameterNumber formats a number without exponent, so that large
+// numbers such as 1000000 aren't flattened to 1e+06.
+func formatToolParameterNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
@@ -33,6 +33,11 @@ func ResourceIBMComputeBareMetal() *schema.Resource {
 		Exists:   resourceIBMComputeBareMetalExists,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"hostname": {
@@ -619,7 +624,7 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Bare Metal Server global ID: %s", gID)
 
 	// wait for machine availability
-	bm, err := waitForBareMetalProvision(&hardware, d, meta, gID)
+	bm, err := waitForBareMetalProvision(&hardware, d, meta, gID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for bare metal server (%s) to become ready: %s", d.Id(), err)
 	}
@@ -825,10 +830,10 @@ func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
-	return deleteHardware(d, meta)
+	return deleteHardware(d, meta, d.Timeout(schema.TimeoutDelete))
 }
 
-func deleteHardware(d dataRetriever, meta interface{}) error {
+func deleteHardware(d dataRetriever, meta interface{}, timeout time.Duration) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetHardwareService(sess)
 	id, err := strconv.Atoi(d.Id())
@@ -836,7 +841,7 @@ func deleteHardware(d dataRetriever, meta interface{}) error {
 		return fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
 	}
 
-	_, err = waitForNoBareMetalActiveTransactions(id, meta)
+	_, err = waitForNoBareMetalActiveTransactions(id, meta, timeout)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting bare metal server while waiting for zero active transactions: %s", err)
 	}
@@ -880,7 +885,7 @@ func resourceIBMComputeBareMetalExists(d *schema.ResourceData, meta interface{})
 // Have to wait on provision date to become available on server that matches
 // hostname and domain.
 // http://sldn.softlayer.com/blog/bpotter/ordering-bare-metal-servers-using-softlayer-api
-func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, meta interface{}, globalIdentifier string) (interface{}, error) {
+func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, meta interface{}, globalIdentifier string, timeout time.Duration) (interface{}, error) {
 	hostname := *hw.Hostname
 	domain := *hw.Domain
 	log.Printf("Waiting for server (%s.%s) to have to be provisioned", hostname, domain)
@@ -919,7 +924,7 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 			return bms[0], "provisioned", nil
 
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
@@ -928,7 +933,7 @@ func waitForBareMetalProvision(hw *datatypes.Hardware, d *schema.ResourceData, m
 	return stateConf.WaitForState()
 }
 
-func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}, error) {
+func waitForNoBareMetalActiveTransactions(id int, meta interface{}, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for server (%d) to have zero active transactions", id)
 	service := services.GetHardwareServerService(meta.(conns.ClientSession).SoftLayerSession())
 
@@ -947,7 +952,7 @@ func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}
 			return bm, "active", nil
 
 		},
-		Timeout:        24 * time.Hour,
+		Timeout:        timeout,
 		Delay:          10 * time.Second,
 		MinTimeout:     1 * time.Minute,
 		NotFoundChecks: 24 * 60,
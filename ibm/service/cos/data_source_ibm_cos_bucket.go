@@ -123,6 +123,12 @@ func DataSourceIBMCosBucket() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of IPv4 or IPv6 addresses ",
 			},
+			"allowed_network_type": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of network types allowed to access the bucket",
+			},
 			"activity_tracking": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -748,6 +754,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	if bucketPtr != nil {
 		if bucketPtr.Firewall != nil {
 			d.Set("allowed_ip", flex.FlattenStringList(bucketPtr.Firewall.AllowedIp))
+			d.Set("allowed_network_type", flex.FlattenStringList(bucketPtr.Firewall.AllowedNetworkType))
 		}
 		if bucketPtr.ActivityTracking != nil {
 			d.Set("activity_tracking", flex.FlattenActivityTrack(bucketPtr.ActivityTracking))
@@ -866,10 +873,10 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	outputwebsite, err := s3Client.GetBucketWebsite(getBucketWebsiteConfigurationInput)
 	var outputptr *s3.WebsiteConfiguration
 	outputptr = (*s3.WebsiteConfiguration)(outputwebsite)
-	if err != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") && !strings.Contains(err.Error(), "The specified bucket does not have a website configuration") {
+	if err != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") && !strings.Contains(err.Error(), websiteConfigurationNotFound) {
 		return err
 	}
-	if outputwebsite.IndexDocument != nil || outputwebsite.RedirectAllRequestsTo != nil {
+	if outputwebsite != nil && (outputwebsite.IndexDocument != nil || outputwebsite.RedirectAllRequestsTo != nil) {
 		websiteConfiguration := flex.WebsiteConfigurationGet(outputptr)
 		if len(websiteConfiguration) > 0 {
 			d.Set("website_configuration", websiteConfiguration)
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMCosBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the objects whose key begins with the prefix",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Character to group the keys by, the keys containing the delimiter after the prefix are returned as common prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the objects whose key comes after this key",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of objects to list, all the objects are listed if not set",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Keys of the objects",
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes of the keys grouped by the delimiter",
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object MD5 hexdigest",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if v, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_after"); ok {
+		input.StartAfter = aws.String(v.(string))
+	}
+	maxKeys := d.Get("max_keys").(int)
+	if maxKeys > 0 && maxKeys < 1000 {
+		input.MaxKeys = aws.Int64(int64(maxKeys))
+	}
+
+	keys := make([]string, 0)
+	commonPrefixes := make([]string, 0)
+	objects := make([]map[string]interface{}, 0)
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, prefix := range page.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(prefix.Prefix))
+		}
+		for _, object := range page.Contents {
+			if maxKeys > 0 && len(keys) >= maxKeys {
+				return false
+			}
+			keys = append(keys, aws.StringValue(object.Key))
+			o := map[string]interface{}{
+				"key":           aws.StringValue(object.Key),
+				"size":          int(aws.Int64Value(object.Size)),
+				"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+				"storage_class": aws.StringValue(object.StorageClass),
+			}
+			if object.LastModified != nil {
+				o["last_modified"] = object.LastModified.Format(time.RFC1123)
+			}
+			objects = append(objects, o)
+		}
+		return maxKeys == 0 || len(keys) < maxKeys
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:objects:%s", bucketCRN, bucketLocation, d.Get("prefix").(string)))
+	d.Set("keys", keys)
+	d.Set("common_prefixes", commonPrefixes)
+	d.Set("objects", objects)
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.0", "logs/a.txt"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "objects.0.size", "18"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "objects.0.etag"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "objects.0.storage_class"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.limited", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.grouped", "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.grouped", "common_prefixes.0", "logs/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			for_each        = toset(["logs/a.txt", "logs/b.txt", "other.txt"])
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = each.value
+			content         = "Acceptance testing"
+		}
+		data "ibm_cos_bucket_objects" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "logs/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "limited" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "logs/"
+			max_keys        = 1
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "grouped" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			delimiter       = "/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}`, name, crn)
+}
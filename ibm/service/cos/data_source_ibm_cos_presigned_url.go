@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMCosPresignedURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosPresignedURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS object key",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "PUT"}, false),
+				Description:  "HTTP method the URL is signed for: GET to download the object, PUT to upload it",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(1, 604800),
+				Description:  "Number of seconds the URL is valid for",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Access key ID of the HMAC credentials of the COS instance",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret access key of the HMAC credentials of the COS instance",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Presigned URL of the object",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the URL expires at, in RFC3339 format",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosPresignedURLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	objectKey := d.Get("key").(string)
+	expiration := time.Duration(d.Get("expiration").(int)) * time.Second
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	visibility := endpointType
+	if endpointType == "direct" {
+		visibility = "private"
+	}
+	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
+	apiEndpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	if apiEndpoint == "" {
+		return diag.FromErr(fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType))
+	}
+
+	// Presigned URLs are signed with the HMAC credentials, IAM tokens can't
+	// sign them
+	s3Conf := aws.NewConfig().
+		WithEndpoint(apiEndpoint).
+		WithRegion(bucketLocation).
+		WithCredentials(credentials.NewStaticCredentials(d.Get("access_key_id").(string), d.Get("secret_access_key").(string), "")).
+		WithS3ForcePathStyle(true)
+	s3Client := s3.New(session.Must(session.NewSession()), s3Conf)
+
+	var req *request.Request
+	if d.Get("method").(string) == "PUT" {
+		req, _ = s3Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+	} else {
+		req, _ = s3Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+	}
+	req.SetContext(ctx)
+
+	signedAt := time.Now().UTC()
+	url, err := req.Presign(expiration)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error presigning URL of COS bucket (%s) object (%s): %s", bucketName, objectKey, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:presigned:%s", getObjectId(bucketCRN, objectKey, bucketLocation), signedAt.Format(time.RFC3339)))
+	d.Set("url", url)
+	d.Set("expires_at", signedAt.Add(expiration).Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSPresignedURLDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSPresignedURLDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_presigned_url.get", "id"),
+					resource.TestMatchResourceAttr("data.ibm_cos_presigned_url.get", "url", regexp.MustCompile(`X-Amz-Signature=`)),
+					resource.TestMatchResourceAttr("data.ibm_cos_presigned_url.get", "url", regexp.MustCompile(`X-Amz-Expires=900`)),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_presigned_url.get", "expires_at"),
+					resource.TestCheckResourceAttr("data.ibm_cos_presigned_url.put", "method", "PUT"),
+					resource.TestMatchResourceAttr("data.ibm_cos_presigned_url.put", "url", regexp.MustCompile(`X-Amz-Signature=`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSPresignedURLDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "%[1]s.txt"
+			content         = "Acceptance testing"
+		}
+		resource "ibm_resource_key" "testacc" {
+			name                 = "%[1]s"
+			resource_instance_id = "%[2]s"
+			parameters           = { "HMAC" = true }
+			role                 = "Reader"
+		}
+		data "ibm_cos_presigned_url" "get" {
+			bucket_crn        = ibm_cos_bucket.testacc.crn
+			bucket_location   = ibm_cos_bucket.testacc.region_location
+			key               = ibm_cos_bucket_object.testacc.key
+			expiration        = 900
+			access_key_id     = ibm_resource_key.testacc.credentials["cos_hmac_keys.access_key_id"]
+			secret_access_key = ibm_resource_key.testacc.credentials["cos_hmac_keys.secret_access_key"]
+		}
+		data "ibm_cos_presigned_url" "put" {
+			bucket_crn        = ibm_cos_bucket.testacc.crn
+			bucket_location   = ibm_cos_bucket.testacc.region_location
+			key               = "upload.txt"
+			method            = "PUT"
+			access_key_id     = ibm_resource_key.testacc.credentials["cos_hmac_keys.access_key_id"]
+			secret_access_key = ibm_resource_key.testacc.credentials["cos_hmac_keys.secret_access_key"]
+		}`, name, crn)
+}
@@ -22,8 +22,12 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var singleSiteLocation = []string{
@@ -65,12 +69,16 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 func ResourceIBMCOSBucket() *schema.Resource {
 	return &schema.Resource{
 		Read:          resourceIBMCOSBucketRead,
-		Create:        resourceIBMCOSBucketCreate,
-		Update:        resourceIBMCOSBucketUpdate,
+		CreateContext: resourceIBMCOSBucketCreateContext,
+		UpdateContext: resourceIBMCOSBucketUpdateContext,
 		Delete:        resourceIBMCOSBucketDelete,
 		Exists:        resourceIBMCOSBucketExists,
 		Importer:      &schema.ResourceImporter{},
-		CustomizeDiff: resourceExpiryValidate,
+		CustomizeDiff: customdiff.Sequence(
+			resourceExpiryValidate,
+			resourceRetentionValidate,
+			flex.AccessTagsCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -97,6 +105,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Computed:    true,
 				Description: "CRN of resource instance",
 			},
+			flex.AccessTags: flex.AccessTagsSchema(),
 			"key_protect": {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -182,6 +191,13 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				ConflictsWith: []string{"satellite_location_id"},
 				Description:   "List of IPv4 or IPv6 addresses ",
 			},
+			"allowed_network_type": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"public", "private", "direct"}, false)},
+				ConflictsWith: []string{"satellite_location_id"},
+				Description:   "List of network types allowed to access the bucket: public, private, direct. The direct network type allows the access through the virtual private endpoints of VPCs",
+			},
 			"activity_tracking": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -204,6 +220,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 						"management_events": {
 							Type:        schema.TypeBool,
 							Optional:    true,
+							Computed:    true,
 							Description: "If set to `true`, all bucket management events will be sent to Activity Tracker.This field only applies if `activity_tracker_crn` is not populated.",
 						},
 						"activity_tracker_crn": {
@@ -959,7 +976,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	if d.HasChange("allowed_ip") {
+	if d.HasChanges("allowed_ip", "allowed_network_type") {
 		firewall := &resourceconfigurationv1.Firewall{}
 		var ips = make([]string, 0)
 		if ip, ok := d.GetOk("allowed_ip"); ok && ip != nil {
@@ -970,6 +987,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			firewall.AllowedIp = []string{}
 		}
+		firewall.AllowedNetworkType = flex.ExpandStringList(d.Get("allowed_network_type").([]interface{}))
 		hasChanged = true
 		bucketPatchModel.Firewall = firewall
 	}
@@ -1011,7 +1029,8 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 					}
 				} else if oldATCrnValue != "" && newATCrnValue == "" {
-					println("this is upgrading to new config")
+					// Moving from the activity tracker instance to the bucket level routing
+					log.Printf("[INFO] Removing the activity tracker instance of COS bucket %s", bucketName)
 					activityTracker.ActivityTrackerCrn = aws.String("")
 				}
 			}
@@ -1050,7 +1069,8 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 						}
 					}
 				} else if oldMMCrnValue != "" && newMMCrnValue == "" {
-					println("Setting the metricsMonitoring crn as null")
+					// Moving from the monitoring instance to the bucket level routing
+					log.Printf("[INFO] Removing the metrics monitoring instance of COS bucket %s", bucketName)
 					metricsMonitoring.MetricsMonitoringCrn = aws.String("")
 				}
 			}
@@ -1074,6 +1094,13 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange(flex.AccessTags) {
+		bucketCRN := fmt.Sprintf("%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName)
+		if err := flex.UpdateAccessTags(d, meta, bucketCRN); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMCOSBucketRead(d, meta)
 }
 
@@ -1192,6 +1219,9 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketCRN := fmt.Sprintf("%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName)
 	d.Set("crn", bucketCRN)
+	if err := flex.ReadAccessTags(d, meta, bucketCRN); err != nil {
+		return err
+	}
 	d.Set("resource_instance_id", serviceID)
 	d.Set("bucket_name", bucketName)
 	d.Set("s3_endpoint_public", apiEndpointPublic)
@@ -1242,9 +1272,11 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 		if bucketPtr.Firewall != nil {
 			d.Set("allowed_ip", flex.FlattenStringList(bucketPtr.Firewall.AllowedIp))
+			d.Set("allowed_network_type", flex.FlattenStringList(bucketPtr.Firewall.AllowedNetworkType))
 		} else {
 
 			d.Set("allowed_ip", []string{})
+			d.Set("allowed_network_type", []string{})
 		}
 		if bucketPtr.ActivityTracking != nil {
 			d.Set("activity_tracking", flex.FlattenActivityTrack(bucketPtr.ActivityTracking))
@@ -1276,23 +1308,17 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
 		return err
 	}
-	if lifecycleptr != nil {
-		archiveRules := flex.ArchiveRuleGet(lifecycleptr.Rules)
-		expireRules := flex.ExpireRuleGet(lifecycleptr.Rules)
-		nc_expRules := flex.Nc_exp_RuleGet(lifecycleptr.Rules)
-		abort_mpuRules := flex.Abort_mpu_RuleGet(lifecycleptr.Rules)
-		if len(archiveRules) > 0 {
-			d.Set("archive_rule", archiveRules)
-		}
-		if len(expireRules) > 0 {
-			d.Set("expire_rule", expireRules)
-		}
-		if len(nc_expRules) > 0 {
-			d.Set("noncurrent_version_expiration", nc_expRules)
-		}
-		if len(abort_mpuRules) > 0 {
-			d.Set("abort_incomplete_multipart_upload_days", abort_mpuRules)
+	// The lifecycle rules are authoritative, the rules removed outside of
+	// terraform are removed from the state as well
+	if err == nil || strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist") {
+		var lifecycleRules []*s3.LifecycleRule
+		if lifecycleptr != nil {
+			lifecycleRules = lifecycleptr.Rules
 		}
+		d.Set("archive_rule", flex.ArchiveRuleGet(lifecycleRules))
+		d.Set("expire_rule", flex.ExpireRuleGet(lifecycleRules))
+		d.Set("noncurrent_version_expiration", flex.Nc_exp_RuleGet(lifecycleRules))
+		d.Set("abort_incomplete_multipart_upload_days", flex.Abort_mpu_RuleGet(lifecycleRules))
 	}
 
 	// Read retention rule
@@ -1336,7 +1362,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 		Bucket: aws.String(bucketName),
 	}
 	output, err := s3Client.GetObjectLockConfiguration(getObjectLockConfigurationInput)
-	if output.ObjectLockConfiguration != nil {
+	if err == nil && output.ObjectLockConfiguration != nil {
 		objectLockEnabled := *output.ObjectLockConfiguration.ObjectLockEnabled
 		if objectLockEnabled == "Enabled" {
 			d.Set("object_lock", true)
@@ -1486,6 +1512,97 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 }
 
+func resourceIBMCOSBucketCreateContext(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIBMCOSBucketCreate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return appendBucketFirewallCBRWarning(context, d, meta)
+}
+
+func resourceIBMCOSBucketUpdateContext(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIBMCOSBucketUpdate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return appendBucketFirewallCBRWarning(context, d, meta)
+}
+
+// appendBucketFirewallCBRWarning warns when context based restrictions rules
+// govern a bucket with a firewall, as both must allow a request. An allowed_ip
+// which is not in the network zones of the rules locks out the applies.
+func appendBucketFirewallCBRWarning(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("allowed_ip", "allowed_network_type") {
+		return nil
+	}
+	if len(d.Get("allowed_ip").([]interface{})) == 0 && len(d.Get("allowed_network_type").([]interface{})) == 0 {
+		return nil
+	}
+
+	cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		log.Printf("[WARN] Skipping the check of the context based restrictions rules of COS bucket %s: %s", d.Get("bucket_name").(string), err)
+		return nil
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		log.Printf("[WARN] Skipping the check of the context based restrictions rules of COS bucket %s: %s", d.Get("bucket_name").(string), err)
+		return nil
+	}
+
+	listRulesOptions := cbrClient.NewListRulesOptions(userDetails.UserAccount)
+	listRulesOptions.SetServiceName("cloud-object-storage")
+	ruleList, response, err := cbrClient.ListRulesWithContext(context, listRulesOptions)
+	if err != nil {
+		log.Printf("[WARN] Skipping the check of the context based restrictions rules of COS bucket %s: %s\n%s", d.Get("bucket_name").(string), err, response)
+		return nil
+	}
+
+	var ruleIDs []string
+	for _, rule := range ruleList.Rules {
+		// Rules in report mode only log the denied requests
+		if flex.StringValue(rule.EnforcementMode) != "enabled" {
+			continue
+		}
+		if cbrRuleGovernsBucket(rule, d.Get("resource_instance_id").(string), d.Get("bucket_name").(string)) {
+			ruleIDs = append(ruleIDs, flex.StringValue(rule.ID))
+		}
+	}
+	if len(ruleIDs) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("COS bucket %s is also governed by context based restrictions rules", d.Get("bucket_name").(string)),
+			Detail:   fmt.Sprintf("The context based restrictions rules %s apply to the bucket in addition to allowed_ip and allowed_network_type. A request must be allowed by both, make sure the network zones of the rules include the addresses and endpoints Terraform uses, or the next apply is denied access to the bucket.", strings.Join(ruleIDs, ", ")),
+		},
+	}
+}
+
+// cbrRuleGovernsBucket reports whether one of the resources of a context based
+// restrictions rule matches the bucket, the attributes of the resource not
+// set apply to all the instances and buckets.
+func cbrRuleGovernsBucket(rule contextbasedrestrictionsv1.Rule, instanceCRN, bucketName string) bool {
+	for _, resource := range rule.Resources {
+		matches := true
+		for _, attribute := range resource.Attributes {
+			value := flex.StringValue(attribute.Value)
+			switch flex.StringValue(attribute.Name) {
+			case "serviceInstance":
+				matches = matches && strings.Contains(instanceCRN, value)
+			case "resourceType":
+				matches = matches && value == "bucket"
+			case "resource":
+				matches = matches && value == bucketName
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	var s3Conf *aws.Config
 	rsConClient, _ := meta.(conns.ClientSession).BluemixSession()
@@ -1777,3 +1894,31 @@ func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta i
 	}
 	return nil
 }
+
+func resourceRetentionValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	oldRetention, newRetention := diff.GetChange("retention_rule")
+	oldList := oldRetention.([]interface{})
+	newList := newRetention.([]interface{})
+	// A retention policy can't be removed from a bucket and a permanent
+	// retention can't be disabled, the update would otherwise be ignored
+	if diff.Id() != "" && len(oldList) > 0 && oldList[0] != nil {
+		if len(newList) == 0 || newList[0] == nil {
+			return fmt.Errorf("[ERROR] The retention policy of a bucket can not be removed once it is set")
+		}
+		oldMap := oldList[0].(map[string]interface{})
+		newMap := newList[0].(map[string]interface{})
+		if oldMap["permanent"].(bool) && !newMap["permanent"].(bool) {
+			return fmt.Errorf("[ERROR] The permanent retention of a bucket can not be disabled once it is enabled")
+		}
+	}
+	if len(newList) > 0 && newList[0] != nil && diff.NewValueKnown("retention_rule.0.default") && diff.NewValueKnown("retention_rule.0.maximum") && diff.NewValueKnown("retention_rule.0.minimum") {
+		retentionMap := newList[0].(map[string]interface{})
+		minimum := retentionMap["minimum"].(int)
+		defaultDays := retentionMap["default"].(int)
+		maximum := retentionMap["maximum"].(int)
+		if minimum > defaultDays || defaultDays > maximum {
+			return fmt.Errorf("[ERROR] The minimum retention period (%d) must be less than or equal to the default retention period (%d), that in turn must be less than or equal to the maximum retention period (%d)", minimum, defaultDays, maximum)
+		}
+	}
+	return nil
+}
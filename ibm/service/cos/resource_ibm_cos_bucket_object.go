@@ -24,6 +24,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -96,10 +97,28 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressMultipartEtag,
+				Description:      "COS object MD5 hexdigest",
+			},
+			"source_hash": {
 				Type:        schema.TypeString,
-				Computed:    true,
 				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Description: "Any value that triggers an upload of the object when it changes, such as the hash of content_file",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Size in MiB of the parts of a multipart upload, when set objects larger than a part are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of parts of a multipart upload which are uploaded in parallel, only used with part_size",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -137,14 +156,16 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
 				Description:  "Retention modes apply different levels of protection to the objects.",
 			},
 			"object_lock_retain_until_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{"object_lock_mode"},
-				ValidateFunc: validation.IsRFC3339Time,
-				Description:  "An object cannot be deleted when the current time is earlier than the retainUntilDate. After this date, the object can be deleted.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"object_lock_mode"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRetainUntilDate,
+				Description:      "An object cannot be deleted when the current time is earlier than the retainUntilDate. After this date, the object can be deleted.",
 			},
 			"website_redirect": {
 				Type:        schema.TypeString,
@@ -175,46 +196,14 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	objectKey := d.Get("key").(string)
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
 	//if website redirect location if given for a an object
+	var websiteRedirect *string
 	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
+		websiteRedirect = aws.String(v.(string))
 	}
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSBucketObject(ctx, s3Client, d, bucketName, objectKey, websiteRedirect); err != nil {
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
@@ -332,7 +321,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("object_lock_mode", out.ObjectLockMode)
 	}
 	if out.ObjectLockRetainUntilDate != nil {
-		d.Set("object_lock_retain_until_date", out.ObjectLockRetainUntilDate.Format(time.RFC1123))
+		d.Set("object_lock_retain_until_date", objectDatetoString(out.ObjectLockRetainUntilDate))
 	}
 	if out.ObjectLockLegalHoldStatus != nil {
 		d.Set("object_lock_legal_hold_status", out.ObjectLockLegalHoldStatus)
@@ -362,53 +351,17 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
+	if d.HasChanges("content", "content_base64", "content_file", "etag", "source_hash") {
+		var websiteRedirect *string
 		if d.HasChange("website_redirect") {
 			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
+				websiteRedirect = aws.String(v.(string))
 			}
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if err := uploadCOSBucketObject(ctx, s3Client, d, bucketName, objectKey, websiteRedirect); err != nil {
+			return diag.FromErr(err)
 		}
-
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
 		putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
@@ -733,9 +686,93 @@ func parseDate(v string) *time.Time {
 	return aws.Time(t)
 }
 
+// suppressEquivalentRetainUntilDate ignores the differences between two dates
+// in different time zones for the same instant.
+func suppressEquivalentRetainUntilDate(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, newDate := parseDate(old), parseDate(new)
+	return oldDate != nil && newDate != nil && oldDate.Equal(*newDate)
+}
+
 func objectDatetoString(t *time.Time) string {
 	if t == nil {
 		return ""
 	}
 	return t.Format(time.RFC3339)
 }
+
+// uploadCOSBucketObject uploads the content of the object. Files are streamed
+// from disk, and when part_size is set objects larger than a part are uploaded
+// in parts.
+func uploadCOSBucketObject(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string, websiteRedirect *string) error {
+	var body io.ReadSeeker = bytes.NewReader([]byte{})
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+
+		body = file
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}()
+	}
+
+	// Objects are uploaded in a single request unless part_size is set, so
+	// that their etag stays the MD5 hexdigest of their content
+	partSize, multipart := d.GetOk("part_size")
+	if !multipart {
+		putInput := &s3.PutObjectInput{
+			Bucket:                  aws.String(bucketName),
+			Key:                     aws.String(objectKey),
+			Body:                    body,
+			WebsiteRedirectLocation: websiteRedirect,
+		}
+		if _, err := s3Client.PutObjectWithContext(ctx, putInput); err != nil {
+			return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		return nil
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(partSize.(int)) * 1024 * 1024
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(objectKey),
+		Body:                    body,
+		WebsiteRedirectLocation: websiteRedirect,
+	}
+	if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// suppressMultipartEtag ignores the etag of an object uploaded in parts, which
+// is not the MD5 hexdigest of its content, when changes of the object are
+// detected with source_hash.
+func suppressMultipartEtag(k, old, new string, d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("source_hash"); !ok {
+		return false
+	}
+	return strings.Contains(old, "-") && !strings.Contains(new, "-")
+}
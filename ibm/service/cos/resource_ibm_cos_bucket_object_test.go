@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"regexp"
 	"testing"
 	"time"
@@ -233,7 +234,7 @@ func TestAccIBMCOSBucketObjectlock_Retention_Invalid_Mode(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccIBMCOSBucketObjectlock_retention_invalid_mode(name, instanceCRN, objectBody, mode, retainUntilDateString),
-				ExpectError: regexp.MustCompile("MalformedXML: The XML you provided was not well-formed or did not validate against our published schema."),
+				ExpectError: regexp.MustCompile("expected object_lock_mode to be one of"),
 			},
 		},
 	})
@@ -273,6 +274,87 @@ func TestAccIBMCOSBucketObjectlock_Retention_Without_Retainuntildate(t *testing.
 	})
 }
 
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile, err := ioutil.TempFile("", "tf-testacc-cos-object")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(objectFile.Name())
+	// Larger than two parts of 5 MiB
+	if err := objectFile.Truncate(12 * 1024 * 1024); err != nil {
+		t.Fatal(err)
+	}
+	objectFile.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile.Name(), "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "part_size", "5"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "source_hash", "v1"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`-3$`)),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile.Name(), "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "source_hash", "v2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSBucketObject_largeFileEtag(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile, err := ioutil.TempFile("", "tf-testacc-cos-object")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(objectFile.Name())
+	// Larger than the default part size of the multipart uploads
+	if err := objectFile.Truncate(12 * 1024 * 1024); err != nil {
+		t.Fatal(err)
+	}
+	objectFile.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_fileEtag(name, instanceCRN, objectFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}$`)),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(objectFile.Name(), []byte("updated"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectConfig_fileEtag(name, instanceCRN, objectFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "7"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "etag", "0f81d52e06caaa4860887488d18271c7"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -321,6 +403,42 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 		}`, name, instanceCRN, objectFile)
 }
 
+func testAccIBMCOSBucketObjectConfig_fileEtag(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "%[1]s.bin"
+			content_file    = "%[3]s"
+			etag            = filemd5("%[3]s")
+		}`, name, instanceCRN, objectFile)
+}
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string, sourceHash string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			key                = "%[1]s.bin"
+			content_file       = "%[3]s"
+			part_size          = 5
+			upload_concurrency = 2
+			source_hash        = "%[4]s"
+		}`, name, instanceCRN, objectFile, sourceHash)
+}
+
 func testAccIBMCOSBucketBucketObject_Versioning_Enabled(name string, key string, instanceCRN string, objectBody1 string, objectBody2 string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
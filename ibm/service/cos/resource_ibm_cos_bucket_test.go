@@ -148,6 +148,39 @@ func TestAccIBMCosBucket_AllowedIP(t *testing.T) {
 
 }
 
+func TestAccIBMCosBucket_AllowedNetworkType(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	bucketRegionType := "cross_region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_allowednetworktype(serviceName, bucketName, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "allowed_network_type.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "allowed_network_type.0", "public"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "allowed_network_type.1", "direct"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_allowedipremoved(serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "allowed_network_type.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Direct(t *testing.T) {
 
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -731,6 +764,42 @@ func TestAccIBMCosBucket_ActivityTracker_Read_False_Write_False_ManagementEvents
 		},
 	})
 }
+func TestAccIBMCosBucket_ActivityTracker_Migrate_ActivityTrackerCrn_To_Bucket_Routing(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-bucket%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	activityTrackerInstanceCRN := acc.ActivityTrackerInstanceCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_activityTracker_With_Crn_ManagementEvents_NotSet(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, activityTrackerInstanceCRN, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance2", "ibm_cos_bucket.bucket2", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.activity_tracker_crn", activityTrackerInstanceCRN),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.management_events", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_activityTracker_Without_Crn(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, true, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance2", "ibm_cos_bucket.bucket2", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.activity_tracker_crn", ""),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.read_data_events", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.write_data_events", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "activity_tracking.0.management_events", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Upload_Object_Activity_Tracker_Enabled_With_CRN(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1097,6 +1166,41 @@ func TestAccIBMCosBucket_MetricsMonitoring_RequestEnabled_False_UsageEnabled_Fal
 	})
 }
 
+func TestAccIBMCosBucket_MetricsMonitoring_Migrate_MonitoringCrn_To_Bucket_Routing(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-bucket%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	metricsMonitoringCrn := acc.MetricsMonitoringCRN
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_metricsMonitoring_With_Crn(cosServiceName, metricsMonitoringCrn, bucketName, bucketRegionType, bucketRegion, bucketClass, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance2", "ibm_cos_bucket.bucket2", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "metrics_monitoring.0.metrics_monitoring_crn", metricsMonitoringCrn),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_metricsMonitoring_Without_Crn(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance2", "ibm_cos_bucket.bucket2", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "metrics_monitoring.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "metrics_monitoring.0.metrics_monitoring_crn", ""),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "metrics_monitoring.0.request_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket2", "metrics_monitoring.0.usage_metrics_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_MetricsMonitoring_Upload_Object_RequestMetrics_True_UsageMetrics_True_MonitoringCrn_Set(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1519,6 +1623,43 @@ func TestAccIBMCosBucket_AbortIncompeleteMPU(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Lifecycle_All_Rules(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_lifecycle_all_rules(cosServiceName, bucketName, bucketRegion, bucketClass, "ACCELERATED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "archive_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "archive_rule.0.type", "ACCELERATED"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.0.noncurrent_days", "30"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.0.days_after_initiation", "7"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_lifecycle_all_rules(cosServiceName, bucketName, bucketRegion, bucketClass, "GLACIER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "archive_rule.0.type", "GLACIER"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_noncurrentversion(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1586,6 +1727,19 @@ func TestAccIBMCosBucket_Retention(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "retention_rule.#", "1"),
 				),
 			},
+			{
+				Config: testAccCheckIBMCosBucket_retention(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, 1, 2, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "retention_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "retention_rule.0.default", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "retention_rule.0.maximum", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "retention_rule.0.minimum", "0"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMCosBucket_retention(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, 3, 2, 0),
+				ExpectError: regexp.MustCompile("must be less than or equal to the maximum retention period"),
+			},
 		},
 	})
 }
@@ -2614,6 +2768,31 @@ func testAccCheckIBMCosBucket_COS_Plan_Storageclass_Mismatch_Type2(serviceName s
 	`, serviceName, bucketName, storageClass, region)
 }
 
+func testAccCheckIBMCosBucket_allowednetworktype(serviceName string, bucketName string, region string, storageClass string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		storage_class         = "%s"
+		cross_region_location = "%s"
+		allowed_network_type  = ["public", "direct"]
+	}
+	`, serviceName, bucketName, storageClass, region)
+}
+
 func testAccCheckIBMCosBucket_allowedip(serviceName string, bucketName string, regiontype string, region string, storageClass string, allowedIp1 string, allowedIp2 string) string {
 
 	return fmt.Sprintf(`
@@ -3609,6 +3788,47 @@ func testAccCheckIBMCosBucket_hard_quota(cosServiceName string, bucketName strin
 	`, cosServiceName, bucketName, region, storageClass, hardQuota)
 }
 
+func testAccCheckIBMCosBucket_lifecycle_all_rules(cosServiceName string, bucketName string, region string, storageClass string, archiveType string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"
+		archive_rule {
+			rule_id = "my-rule-id-bucket-arch"
+			enable  = true
+			days    = 1
+			type    = "%s"
+		}
+		noncurrent_version_expiration {
+			rule_id         = "my-rule-id-bucket-ncversion"
+			enable          = true
+			prefix          = ""
+			noncurrent_days = 30
+		}
+		abort_incomplete_multipart_upload_days {
+			rule_id               = "my-rule-id-bucket-abortmpu"
+			enable                = true
+			prefix                = ""
+			days_after_initiation = 7
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, archiveType)
+}
+
 func testAccCheckIBMCosBucket_abortincompletempu(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, ruleId string, enable bool, daysAfterInitiation int, prefix string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "cos_group" {
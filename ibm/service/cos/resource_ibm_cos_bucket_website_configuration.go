@@ -3,6 +3,7 @@ package cos
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -335,7 +336,6 @@ func websiteConfigurationSet(websiteConfigurationList []interface{}) (*s3.Websit
 			if err := json.Unmarshal([]byte(routingRulesJsonSet.(string)), &unmarshalledRules); err != nil {
 				return nil, fmt.Errorf("failed to update the json routing rules in the website configuration : %v", err)
 			}
-			fmt.Println("unmarshal rules : ", unmarshalledRules)
 			website_configuration.RoutingRules = unmarshalledRules
 		}
 	}
@@ -354,6 +354,9 @@ func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta
 		return err
 	}
 	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
 	var websiteConfiguration *s3.WebsiteConfiguration
 	configuration, ok := d.GetOk("website_configuration")
 	if ok {
@@ -439,6 +442,11 @@ func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta i
 	output, err := s3Client.GetBucketWebsite(getBucketWebsiteConfigurationInput)
 	var outputptr *s3.WebsiteConfiguration
 	outputptr = (*s3.WebsiteConfiguration)(output)
+	if err != nil && strings.Contains(err.Error(), websiteConfigurationNotFound) {
+		log.Printf("[WARN] Website configuration of the COS bucket %s not found, removing it from the state", bucketName)
+		d.SetId("")
+		return nil
+	}
 	if err != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
 		return err
 	}
@@ -472,8 +480,8 @@ func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta
 		Bucket: aws.String(bucketName),
 	}
 	_, err = s3Client.DeleteBucketWebsite(deleteBucketWebsiteInput)
-	if err != nil {
-		return fmt.Errorf("failed to delete the objectlock configuration on the COS bucket %s, %v", bucketName, err)
+	if err != nil && !strings.Contains(err.Error(), websiteConfigurationNotFound) {
+		return fmt.Errorf("failed to delete the website configuration on the COS bucket %s, %v", bucketName, err)
 	}
 	return nil
 }
@@ -502,6 +510,10 @@ func parseWebsiteId(id string, info string) string {
 	return parseBucketId(bucketCRN, info)
 }
 
+// websiteConfigurationNotFound is the error message of COS for a bucket
+// without website configuration.
+const websiteConfigurationNotFound = "The specified bucket does not have a website configuration"
+
 func getWebsiteEndpoint(bucketName string, bucketLocation string) string {
 	return fmt.Sprintf("https://%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation)
 }
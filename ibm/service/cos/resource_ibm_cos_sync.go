@@ -0,0 +1,457 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSSyncCreate,
+		ReadContext:   resourceIBMCOSSyncRead,
+		UpdateContext: resourceIBMCOSSyncUpdate,
+		DeleteContext: resourceIBMCOSSyncDelete,
+		CustomizeDiff: resourceIBMCOSSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN to synchronize the objects to",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix of the keys of the objects in the bucket",
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_dir", "source_bucket_crn"},
+				Description:  "Local directory whose files are synchronized to the bucket",
+			},
+			"source_bucket_crn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_dir", "source_bucket_crn"},
+				Description:  "COS bucket CRN whose objects are synchronized to the bucket, in the same location as the bucket",
+			},
+			"source_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_bucket_crn"},
+				Description:  "Only synchronize the objects of the source bucket whose key begins with the prefix",
+			},
+			"delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix of the bucket which are not in the source",
+			},
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "MD5 hexdigests or etags of the synchronized objects in the source, by key relative to the prefix",
+			},
+			"etags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Etags of the synchronized objects in the bucket, by key relative to the prefix",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := cosSyncObjects(ctx, d, m, map[string]interface{}{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, d.Get("prefix").(string), bucketLocation))
+
+	return resourceIBMCOSSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketName := strings.Split(d.Get("bucket_crn").(string), ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+
+	s3Client, err := cosSyncS3Client(d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string), m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	headBucketInput := &s3.HeadBucketInput{
+		Bucket: aws.String(bucketName),
+	}
+	if _, err := s3Client.HeadBucketWithContext(ctx, headBucketInput); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && (awsErr.Code() == "NotFound" || awsErr.Code() == s3.ErrCodeNoSuchBucket) {
+			log.Printf("[WARN] COS bucket (%s) not found, removing the sync from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading COS bucket (%s): %s", bucketName, err))
+	}
+
+	// The objects removed from the bucket, or changed since they were
+	// synchronized, are dropped so that the next apply synchronizes them again
+	oldEtags := d.Get("etags").(map[string]interface{})
+	objects := make(map[string]interface{})
+	etags := make(map[string]interface{})
+	for key, checksum := range d.Get("objects").(map[string]interface{}) {
+		if checksum.(string) == "" {
+			continue
+		}
+		etag, err := cosSyncHeadObject(ctx, s3Client, bucketName, prefix+key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if etag == "" {
+			log.Printf("[WARN] COS object (%s) of COS bucket (%s) not found, it will be synchronized again", prefix+key, bucketName)
+			continue
+		}
+		if oldEtag, ok := oldEtags[key]; ok && oldEtag.(string) != etag {
+			log.Printf("[WARN] COS object (%s) of COS bucket (%s) changed outside of Terraform, it will be synchronized again", prefix+key, bucketName)
+			continue
+		}
+		objects[key] = checksum
+		etags[key] = etag
+	}
+
+	// The objects not in the source are deleted by the next apply
+	if d.Get("delete").(bool) {
+		targetObjects, err := cosSyncListObjects(ctx, s3Client, bucketName, prefix)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for key := range targetObjects {
+			if _, ok := objects[key]; !ok {
+				objects[key] = ""
+			}
+		}
+	}
+	d.Set("objects", objects)
+	d.Set("etags", etags)
+
+	return nil
+}
+
+func resourceIBMCOSSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("objects", "source_dir", "source_bucket_crn", "source_prefix", "delete") {
+		oldObjects, _ := d.GetChange("objects")
+		if err := cosSyncObjects(ctx, d, m, oldObjects.(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketName := strings.Split(d.Get("bucket_crn").(string), ":bucket:")[1]
+	prefix := d.Get("prefix").(string)
+
+	s3Client, err := cosSyncS3Client(d.Get("bucket_crn").(string), d.Get("bucket_location").(string), d.Get("endpoint_type").(string), m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The objects not in the source were not synchronized by the resource
+	for key, checksum := range d.Get("objects").(map[string]interface{}) {
+		if checksum.(string) == "" {
+			continue
+		}
+		if err := cosSyncDeleteObject(ctx, s3Client, bucketName, prefix+key); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMCOSSyncCustomizeDiff plans the objects of the source, their
+// changed checksums trigger the synchronization.
+func resourceIBMCOSSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("source_bucket_crn") || !diff.NewValueKnown("source_prefix") {
+		return diff.SetNewComputed("objects")
+	}
+
+	var sourceObjects map[string]string
+	var err error
+	if sourceDir, ok := diff.GetOk("source_dir"); ok {
+		sourceObjects, err = cosSyncListDir(sourceDir.(string))
+	} else {
+		sourceObjects, err = cosSyncListSourceBucket(ctx, diff.Get("source_bucket_crn").(string), diff.Get("source_prefix").(string), diff.Get("bucket_location").(string), diff.Get("endpoint_type").(string), m)
+	}
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]interface{}, len(sourceObjects))
+	for key, checksum := range sourceObjects {
+		objects[key] = checksum
+	}
+	if !reflect.DeepEqual(objects, diff.Get("objects").(map[string]interface{})) {
+		return diff.SetNew("objects", objects)
+	}
+	return nil
+}
+
+// cosSyncObjects uploads or copies the objects of the source whose checksum
+// is not in oldObjects, and deletes the objects of oldObjects not in the
+// source when delete is set.
+func cosSyncObjects(ctx context.Context, d *schema.ResourceData, m interface{}, oldObjects map[string]interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	prefix := d.Get("prefix").(string)
+
+	s3Client, err := cosSyncS3Client(bucketCRN, bucketLocation, endpointType, m)
+	if err != nil {
+		return err
+	}
+
+	var sourceObjects map[string]string
+	sourceDir := d.Get("source_dir").(string)
+	sourceBucketCRN := d.Get("source_bucket_crn").(string)
+	sourcePrefix := d.Get("source_prefix").(string)
+	if sourceDir != "" {
+		sourceObjects, err = cosSyncListDir(sourceDir)
+	} else {
+		sourceObjects, err = cosSyncListSourceBucket(ctx, sourceBucketCRN, sourcePrefix, bucketLocation, endpointType, m)
+	}
+	if err != nil {
+		return err
+	}
+
+	oldEtags := d.Get("etags").(map[string]interface{})
+	etags := make(map[string]interface{}, len(sourceObjects))
+	uploader := s3manager.NewUploaderWithClient(s3Client)
+	for key, checksum := range sourceObjects {
+		if oldChecksum, ok := oldObjects[key]; ok && oldChecksum.(string) == checksum {
+			if oldEtag, ok := oldEtags[key]; ok {
+				etags[key] = oldEtag
+			}
+			continue
+		}
+		if sourceDir != "" {
+			err = cosSyncUploadFile(ctx, uploader, bucketName, prefix+key, filepath.Join(sourceDir, filepath.FromSlash(key)))
+		} else {
+			sourceBucketName := strings.Split(sourceBucketCRN, ":bucket:")[1]
+			copyInput := &s3.CopyObjectInput{
+				Bucket:     aws.String(bucketName),
+				Key:        aws.String(prefix + key),
+				CopySource: aws.String(url.PathEscape(sourceBucketName + "/" + sourcePrefix + key)),
+			}
+			if _, copyErr := s3Client.CopyObjectWithContext(ctx, copyInput); copyErr != nil {
+				err = fmt.Errorf("[ERROR] Error copying object (%s) of COS bucket (%s) to COS bucket (%s): %s", sourcePrefix+key, sourceBucketName, bucketName, copyErr)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		// The etag of the object is recorded to detect the changes made
+		// outside of Terraform, it differs from the checksum of the source
+		// for the multipart uploads
+		etag, err := cosSyncHeadObject(ctx, s3Client, bucketName, prefix+key)
+		if err != nil {
+			return err
+		}
+		etags[key] = etag
+	}
+
+	if d.Get("delete").(bool) {
+		for key := range oldObjects {
+			if _, ok := sourceObjects[key]; ok {
+				continue
+			}
+			if err := cosSyncDeleteObject(ctx, s3Client, bucketName, prefix+key); err != nil {
+				return err
+			}
+		}
+	}
+
+	objects := make(map[string]interface{}, len(sourceObjects))
+	for key, checksum := range sourceObjects {
+		objects[key] = checksum
+	}
+	d.Set("objects", objects)
+	d.Set("etags", etags)
+	return nil
+}
+
+func cosSyncS3Client(bucketCRN, bucketLocation, endpointType string, m interface{}) (*s3.S3, error) {
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	return getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+}
+
+// cosSyncListDir returns the MD5 hexdigests of the files of the directory, by
+// their slash separated path relative to the directory.
+func cosSyncListDir(dir string) (map[string]string, error) {
+	objects := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+		objects[filepath.ToSlash(rel)] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", dir, err)
+	}
+	return objects, nil
+}
+
+// cosSyncListSourceBucket returns the etags of the objects of the source
+// bucket, by their key relative to the source prefix.
+func cosSyncListSourceBucket(ctx context.Context, sourceBucketCRN, sourcePrefix, bucketLocation, endpointType string, m interface{}) (map[string]string, error) {
+	s3Client, err := cosSyncS3Client(sourceBucketCRN, bucketLocation, endpointType, m)
+	if err != nil {
+		return nil, err
+	}
+	return cosSyncListObjects(ctx, s3Client, strings.Split(sourceBucketCRN, ":bucket:")[1], sourcePrefix)
+}
+
+// cosSyncListObjects returns the etags of the objects of the bucket, by their
+// key relative to the prefix.
+func cosSyncListObjects(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := strings.TrimPrefix(aws.StringValue(object.Key), prefix)
+			objects[key] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func cosSyncUploadFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, key, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+		}
+	}()
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+		Body:   file,
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		uploadInput.ContentType = aws.String(contentType)
+	}
+	if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// cosSyncHeadObject returns the etag of the object of the bucket, or an empty
+// string if the object doesn't exist.
+func cosSyncHeadObject(ctx context.Context, s3Client *s3.S3, bucketName, key string) (string, error) {
+	headInput := &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}
+	out, err := s3Client.HeadObjectWithContext(ctx, headInput)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NotFound" {
+			return "", nil
+		}
+		return "", fmt.Errorf("[ERROR] Error reading object (%s) of COS bucket (%s): %s", key, bucketName, err)
+	}
+	return strings.Trim(aws.StringValue(out.ETag), `"`), nil
+}
+
+func cosSyncDeleteObject(ctx context.Context, s3Client *s3.S3, bucketName, key string) error {
+	deleteInput := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}
+	if _, err := s3Client.DeleteObjectWithContext(ctx, deleteInput); err != nil {
+		return fmt.Errorf("[ERROR] Error deleting object (%s) of COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
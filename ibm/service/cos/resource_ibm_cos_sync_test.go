@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSSync_sourceDir(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	sourceDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(sourceDir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "css", "site.css"), []byte("body {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSSyncConfig_sourceDir(name, acc.CosCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_sync.testacc", "objects.%", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_sync.testacc", "objects.index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttrSet("ibm_cos_sync.testacc", "objects.css/site.css"),
+					resource.TestCheckResourceAttr("ibm_cos_sync.testacc", "etags.%", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_sync.testacc", "etags.index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html><body></body></html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSSyncConfig_sourceDir(name, acc.CosCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_sync.testacc", "objects.%", "1"),
+					resource.TestCheckNoResourceAttr("ibm_cos_sync.testacc", "objects.css/site.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSSyncConfig_sourceDir(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			source_dir      = "%[3]s"
+			delete          = true
+		}
+		data "ibm_cos_bucket_objects" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "site/"
+			depends_on      = [ibm_cos_sync.testacc]
+		}`, name, instanceCRN, sourceDir)
+}
@@ -140,7 +140,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
 			validateGroupsDiff,
-			validateUsersDiff),
+			validateUsersDiff,
+			flex.AccessTagsCustomizeDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -294,6 +295,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			flex.AccessTags: flex.AccessTagsSchema(),
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -1104,7 +1106,7 @@ type CountLimit struct {
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1323,8 +1325,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return diag.FromErr(
-			fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response))
+		return flex.SDKQuotaErrorf(err, response, "CreateResourceInstance failed", "ibm_database", "create", meta).GetDiag()
 	}
 	d.SetId(*instance.ID)
 
@@ -1402,8 +1403,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTags(d, "tags", meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1411,6 +1411,9 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				"Error on create of ibm database (%s) tags: %s", d.Id(), err)
 		}
 	}
+	if err = flex.UpdateAccessTags(d, meta, *instance.CRN); err != nil {
+		return diag.FromErr(err)
+	}
 
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
@@ -1654,6 +1657,9 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 			"Error on get of ibm Database tags (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	if err = flex.ReadAccessTags(d, meta, *instance.CRN); err != nil {
+		return diag.FromErr(err)
+	}
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -1859,6 +1865,9 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				"[ERROR] Error on update of Database (%s) tags: %s", d.Id(), err)
 		}
 	}
+	if err = flex.UpdateAccessTags(d, meta, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
 
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// searchPageLimit is the maximum number of items returned by a call to the
+// Global Search API.
+const searchPageLimit = 1000
+
+// searchFields are the fields of the matching resources always returned by
+// ibm_cloud_resource_search.
+var searchFields = []string{"crn", "name", "type", "family", "region", "resource_group_id", "service_name", "tags", "access_tags", "service_tags"}
+
+func DataSourceIBMCloudResourceSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCloudResourceSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The Lucene-formatted query string, for example `type:resource-instance AND tags:\"env:prod\"`.",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The additional fields of the matching resources to return in the properties of the items.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      searchPageLimit,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of matching resources to return.",
+			},
+			"is_deleted": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "false",
+				ValidateFunc: validation.StringInSlice([]string{"true", "false", "any"}, false),
+				Description:  "Whether to return the deleted resources: `true`, `false` or `any`.",
+			},
+			"crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the matching resources.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource, for example `resource-instance`.",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The family of the resource, for example `resource_controller`.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource.",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource.",
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service of the resource.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags of the resource.",
+						},
+						"access_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The access management tags of the resource.",
+						},
+						"service_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The service tags of the resource.",
+						},
+						"properties": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The additional fields of the resource, JSON encoded unless they are strings.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCloudResourceSearchRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return flex.SDKErrorf(err, nil, "failed to get the global search client", "(Data) ibm_cloud_resource_search", "read").GetDiag()
+	}
+
+	query := d.Get("query").(string)
+	maxResults := d.Get("max_results").(int)
+	extraFields := flex.ExpandStringList(d.Get("fields").([]interface{}))
+
+	searchOptions := &globalsearchv2.SearchOptions{}
+	searchOptions.SetQuery(query)
+	searchOptions.SetFields(append(append([]string{}, searchFields...), extraFields...))
+	searchOptions.SetIsDeleted(d.Get("is_deleted").(string))
+
+	var items []globalsearchv2.ResultItem
+	for len(items) < maxResults {
+		searchOptions.SetLimit(int64(min(maxResults-len(items), searchPageLimit)))
+		result, response, err := gsClient.SearchWithContext(context, searchOptions)
+		if err != nil {
+			return flex.SDKErrorf(err, response, fmt.Sprintf("failed to search the resources matching %q", query), "(Data) ibm_cloud_resource_search", "read").GetDiag()
+		}
+		items = append(items, result.Items...)
+		if len(result.Items) < int(*searchOptions.Limit) || result.SearchCursor == nil {
+			break
+		}
+		searchOptions.SearchCursor = result.SearchCursor
+	}
+
+	crns := make([]string, 0, len(items))
+	flattened := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		crn := flex.StringValue(item.CRN)
+		crns = append(crns, crn)
+		flattened = append(flattened, flattenSearchResultItem(crn, item, extraFields))
+	}
+
+	d.SetId(query)
+	if err := d.Set("crns", crns); err != nil {
+		return flex.SDKErrorf(err, nil, "failed to set crns", "(Data) ibm_cloud_resource_search", "read").GetDiag()
+	}
+	if err := d.Set("items", flattened); err != nil {
+		return flex.SDKErrorf(err, nil, "failed to set items", "(Data) ibm_cloud_resource_search", "read").GetDiag()
+	}
+	return nil
+}
+
+func flattenSearchResultItem(crn string, item globalsearchv2.ResultItem, extraFields []string) map[string]interface{} {
+	properties := map[string]string{}
+	for _, field := range extraFields {
+		switch value := item.GetProperty(field).(type) {
+		case nil:
+		case string:
+			properties[field] = value
+		default:
+			if encoded, err := json.Marshal(value); err == nil {
+				properties[field] = string(encoded)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"crn":               crn,
+		"name":              searchStringProperty(item, "name"),
+		"type":              searchStringProperty(item, "type"),
+		"family":            searchStringProperty(item, "family"),
+		"region":            searchStringProperty(item, "region"),
+		"resource_group_id": searchStringProperty(item, "resource_group_id"),
+		"service_name":      searchStringProperty(item, "service_name"),
+		"tags":              searchListProperty(item, "tags"),
+		"access_tags":       searchListProperty(item, "access_tags"),
+		"service_tags":      searchListProperty(item, "service_tags"),
+		"properties":        properties,
+	}
+}
+
+func searchStringProperty(item globalsearchv2.ResultItem, name string) string {
+	if value, ok := item.GetProperty(name).(string); ok {
+		return value
+	}
+	return ""
+}
+
+func searchListProperty(item globalsearchv2.ResultItem, name string) []string {
+	values, _ := item.GetProperty(name).([]interface{})
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		list = append(list, fmt.Sprintf("%v", value))
+	}
+	return list
+}
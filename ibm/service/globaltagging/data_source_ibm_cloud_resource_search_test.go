@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudResourceSearchDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-search-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudResourceSearchDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cloud_resource_search.search", "crns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_cloud_resource_search.search", "crns.0", "ibm_resource_instance.instance", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_cloud_resource_search.search", "items.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_cloud_resource_search.search", "items.0.type", "resource-instance"),
+					resource.TestCheckResourceAttrSet("data.ibm_cloud_resource_search.search", "items.0.properties.creation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudResourceSearchDataSource(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name     = "%[1]s"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+		tags     = ["env:%[1]s"]
+	}
+
+	data "ibm_cloud_resource_search" "search" {
+		query  = "type:resource-instance AND tags:\"env:%[1]s\" AND crn:\"${ibm_resource_instance.instance.crn}\""
+		fields = ["creation_date"]
+	}
+	`, name)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -40,7 +39,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -178,12 +177,7 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 
 	// Fetch tags from schematics only if they are user tags
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
-		schematicTags := os.Getenv("IC_ENV_TAGS")
-		var envTags []string
-		if schematicTags != "" {
-			envTags = strings.Split(schematicTags, ",")
-			add = append(add, envTags...)
-		}
+		add = flex.MergeEnvTags(add, meta)
 	}
 
 	if len(add) > 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
@@ -157,12 +158,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	}
 
 	grpID := parts[0]
-	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
-	offset := int64(0)
-	// lets fetch 100 in a single pagination
-	limit := int64(100)
-	listAccessGroupMembersOptions.SetLimit(limit)
-	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	allMembers, detailedResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
 	if err != nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 			d.SetId("")
@@ -170,90 +166,14 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		}
 		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
 	}
-	allMembers := members.Members
-	totalMembers := flex.IntValue(members.TotalCount)
-	for len(allMembers) < totalMembers {
-		offset = offset + limit
-		listAccessGroupMembersOptions.SetOffset(offset)
-		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
-		}
-		allMembers = append(allMembers, members.Members...)
-	}
 
 	d.Set("access_group_id", grpID)
 
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	accountID := userDetails.UserAccount
-
-	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	members, ibmID, serviceID, profileID, err := flattenAccessGroupMemberIDs(allMembers, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	client := userManagement.UserInvite()
-	res, err := client.ListUsers(accountID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	start := ""
-	allrecs := []iamidentityv1.ServiceID{}
-	var pg int64 = 100
-	for {
-		listServiceIDOptions := iamidentityv1.ListServiceIdsOptions{
-			AccountID: &userDetails.UserAccount,
-			Pagesize:  &pg,
-		}
-		if start != "" {
-			listServiceIDOptions.Pagetoken = &start
-		}
-
-		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
-		}
-		start = flex.GetNextIAM(serviceIDs.Next)
-		allrecs = append(allrecs, serviceIDs.Serviceids...)
-		if start == "" {
-			break
-		}
-	}
-
-	profileStart := ""
-	allprofiles := []iamidentityv1.TrustedProfile{}
-	var plimit int64 = 100
-	for {
-		listProfilesOptions := iamidentityv1.ListProfilesOptions{
-			AccountID: &userDetails.UserAccount,
-			Pagesize:  &plimit,
-		}
-		if profileStart != "" {
-			listProfilesOptions.Pagetoken = &profileStart
-		}
-
-		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp))
-		}
-		profileStart = flex.GetNextIAM(profileIDs.Next)
-		allprofiles = append(allprofiles, profileIDs.Profiles...)
-		if profileStart == "" {
-			break
-		}
-	}
-
-	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
-	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
+	d.Set("members", members)
 	if len(ibmID) > 0 {
 		d.Set("ibm_ids", ibmID)
 	}
@@ -556,3 +476,104 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 	}
 	return *profileID, nil
 }
+
+// listAccessGroupMembers returns all the members of the access group grpID.
+func listAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string) ([]iamaccessgroupsv2.ListGroupMembersResponseMember, *core.DetailedResponse, error) {
+	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
+	offset := int64(0)
+	// lets fetch 100 in a single pagination
+	limit := int64(100)
+	listAccessGroupMembersOptions.SetLimit(limit)
+	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	if err != nil {
+		return nil, detailedResponse, err
+	}
+	allMembers := members.Members
+	totalMembers := flex.IntValue(members.TotalCount)
+	for len(allMembers) < totalMembers {
+		offset = offset + limit
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil {
+			return nil, detailedResponse, err
+		}
+		allMembers = append(allMembers, members.Members...)
+	}
+	return allMembers, detailedResponse, nil
+}
+
+// flattenAccessGroupMemberIDs maps the members of an access group to the
+// members attribute and to the user emails, service IDs and trusted profile
+// IDs of the account.
+func flattenAccessGroupMemberIDs(allMembers []iamaccessgroupsv2.ListGroupMembersResponseMember, meta interface{}) ([]map[string]interface{}, []string, []string, []string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	accountID := userDetails.UserAccount
+
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	client := userManagement.UserInvite()
+	res, err := client.ListUsers(accountID)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	start := ""
+	allrecs := []iamidentityv1.ServiceID{}
+	var pg int64 = 100
+	for {
+		listServiceIDOptions := iamidentityv1.ListServiceIdsOptions{
+			AccountID: &userDetails.UserAccount,
+			Pagesize:  &pg,
+		}
+		if start != "" {
+			listServiceIDOptions.Pagetoken = &start
+		}
+
+		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp)
+		}
+		start = flex.GetNextIAM(serviceIDs.Next)
+		allrecs = append(allrecs, serviceIDs.Serviceids...)
+		if start == "" {
+			break
+		}
+	}
+
+	profileStart := ""
+	allprofiles := []iamidentityv1.TrustedProfile{}
+	var plimit int64 = 100
+	for {
+		listProfilesOptions := iamidentityv1.ListProfilesOptions{
+			AccountID: &userDetails.UserAccount,
+			Pagesize:  &plimit,
+		}
+		if profileStart != "" {
+			listProfilesOptions.Pagetoken = &profileStart
+		}
+
+		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp)
+		}
+		profileStart = flex.GetNextIAM(profileIDs.Next)
+		allprofiles = append(allprofiles, profileIDs.Profiles...)
+		if profileStart == "" {
+			break
+		}
+	}
+
+	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
+	return flex.FlattenAccessGroupMembers(allMembers, res, allrecs), ibmID, serviceID, profileID, nil
+}
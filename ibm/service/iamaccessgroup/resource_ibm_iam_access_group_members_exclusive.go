@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccessGroupMembersExclusive manages the complete list of the
+// members of an access group, the members added outside of it are removed.
+func ResourceIBMIAMAccessGroupMembersExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupMembersExclusiveCreate,
+		ReadContext:   resourceIBMIAMAccessGroupMembersExclusiveRead,
+		UpdateContext: resourceIBMIAMAccessGroupMembersExclusiveUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupMembersExclusiveDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of the access group",
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_members_exclusive",
+					"access_group_id"),
+			},
+
+			"ibm_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "The IBMid of the users which are the members of the access group",
+			},
+
+			"iam_service_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The service IDs which are the members of the access group",
+			},
+
+			"iam_profile_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The trusted profile IDs which are the members of the access group",
+			},
+
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMAccessGroupMembersExclusiveValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_group_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "iam",
+			CloudDataRange:             []string{"service:access_group", "resolved_to:id"},
+			Optional:                   true})
+
+	iBMIAMAccessGroupMembersExclusiveValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_group_members_exclusive", Schema: validateSchema}
+	return &iBMIAMAccessGroupMembersExclusiveValidator
+}
+
+func resourceIBMIAMAccessGroupMembersExclusiveCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	grpID := d.Get("access_group_id").(string)
+	if err := resourceIBMIAMAccessGroupMembersExclusiveSet(d, meta, grpID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(grpID)
+
+	return resourceIBMIAMAccessGroupMembersExclusiveRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupMembersExclusiveRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grpID := d.Id()
+	allMembers, detailedResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
+	if err != nil {
+		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+	}
+
+	d.Set("access_group_id", grpID)
+
+	// The member lists are set even when empty, so that the members added or
+	// removed outside of Terraform show up in the plan.
+	members, ibmID, serviceID, profileID, err := flattenAccessGroupMemberIDs(allMembers, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("members", members)
+	d.Set("ibm_ids", ibmID)
+	d.Set("iam_service_ids", serviceID)
+	d.Set("iam_profile_ids", profileID)
+	return nil
+}
+
+func resourceIBMIAMAccessGroupMembersExclusiveUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("ibm_ids", "iam_service_ids", "iam_profile_ids") {
+		if err := resourceIBMIAMAccessGroupMembersExclusiveSet(d, meta, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMAccessGroupMembersExclusiveRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupMembersExclusiveDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grpID := d.Id()
+	allMembers, detailedResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
+	if err != nil {
+		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+	}
+
+	for _, member := range allMembers {
+		if err := removeAccessGroupMember(iamAccessGroupsClient, grpID, *member.IamID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMIAMAccessGroupMembersExclusiveSet makes the configured users,
+// service IDs and trusted profiles the only members of the access group.
+func resourceIBMIAMAccessGroupMembersExclusiveSet(d *schema.ResourceData, meta interface{}, grpID string) error {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	userids, err := flex.FlattenUserIds(userDetails.UserAccount, flex.ExpandStringList(d.Get("ibm_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return err
+	}
+	serviceids, err := FlattenServiceIds(flex.ExpandStringList(d.Get("iam_service_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return err
+	}
+	profileids, err := FlattenProfileIds(flex.ExpandStringList(d.Get("iam_profile_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return err
+	}
+
+	allMembers, detailedResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+	}
+	current := make(map[string]bool, len(allMembers))
+	for _, member := range allMembers {
+		current[*member.IamID] = true
+	}
+
+	wanted := make(map[string]bool, len(userids)+len(serviceids)+len(profileids))
+	missing := func(iamIDs []string) []string {
+		var add []string
+		for _, iamID := range iamIDs {
+			wanted[iamID] = true
+			if !current[iamID] {
+				add = append(add, iamID)
+			}
+		}
+		return add
+	}
+	addUsers, addServiceids, addProfileids := missing(userids), missing(serviceids), missing(profileids)
+
+	if len(addUsers) > 0 || len(addServiceids) > 0 || len(addProfileids) > 0 {
+		members := prepareMemberAddRequest(iamAccessGroupsClient, addUsers, addServiceids, addProfileids)
+		addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+		addMembersToAccessGroupOptions.SetMembers(members)
+		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		if err != nil || membership == nil {
+			return fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse)
+		}
+	}
+
+	for _, member := range allMembers {
+		if wanted[*member.IamID] {
+			continue
+		}
+		log.Printf("[INFO] Removing the member %s of type %s not in the configuration of the access group %s", *member.IamID, flex.StringValue(member.Type), grpID)
+		if err := removeAccessGroupMember(iamAccessGroupsClient, grpID, *member.IamID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeAccessGroupMember removes the member iamID of the access group grpID,
+// a member already removed is ignored.
+func removeAccessGroupMember(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID, iamID string) error {
+	removeMemberFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, iamID)
+	detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions)
+	if err != nil {
+		if detailResponse != nil && detailResponse.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error removing member %s from group(%s): %s. API Response: %s", iamID, grpID, err, detailResponse)
+	}
+	return nil
+}
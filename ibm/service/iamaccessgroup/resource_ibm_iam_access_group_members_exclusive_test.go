@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupMembersExclusive_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_access_group_members_exclusive.accgroupmem"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMembersExclusiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMembersExclusiveConfig(name, sname, fmt.Sprintf(`["%s"]`, acc.IAMUser)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ibm_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iam_service_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMembersExclusiveConfig(name, sname, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ibm_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "iam_service_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupMembersExclusiveDestroy(s *terraform.State) error {
+	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_members_exclusive" {
+			continue
+		}
+
+		grpID := rs.Primary.ID
+		listAccessGroupMembersOptions := &iamaccessgroupsv2.ListAccessGroupMembersOptions{
+			AccessGroupID: &grpID,
+		}
+		members, detailResponse, err := accClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil {
+			if detailResponse != nil && detailResponse.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error waiting for access group members (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+		if len(members.Members) > 0 {
+			return fmt.Errorf("Access group %s still has %d members", grpID, len(members.Members))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupMembersExclusiveConfig(name, sname, ibmIDs string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members_exclusive" "accgroupmem" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		ibm_ids         = %s
+		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+	}`, name, sname, ibmIDs)
+}
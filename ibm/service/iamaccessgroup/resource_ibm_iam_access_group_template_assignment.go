@@ -50,6 +50,7 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 			"template_id": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_template_assignment", "template_id"),
 				Description:  "The ID of the template that the assignment is based on.",
 			},
@@ -62,12 +63,14 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 			"target_type": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_template_assignment", "target_type"),
 				Description:  "The type of the entity that the assignment applies to.",
 			},
 			"target": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_template_assignment", "target"),
 				Description:  "The ID of the entity that the assignment applies to.",
 			},
@@ -309,7 +312,10 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
 		}
-		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating assignment %s", err))
+		}
 	}
 
 	return resourceIBMIAMAccessGroupTemplateAssignmentRead(context, d, meta)
@@ -327,11 +333,18 @@ func resourceIBMIAMAccessGroupTemplateAssignmentDelete(context context.Context,
 
 	response, err := iamAccessGroupsClient.DeleteAssignmentWithContext(context, deleteAssignmentOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[DEBUG] DeleteAssignmentWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting assignment %s", err))
+	}
 
 	d.SetId("")
 
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// apiKeyRotationSchema returns the rotation block shared by the user and
+// service API key resources.
+func apiKeyRotationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"apikey"},
+		Description:   "Rotates the API key, by creating a new API key in place of this one, when it is older than max_age_days or when the keepers change.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_age_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of days after which the API key is rotated.",
+				},
+				"keepers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Arbitrary values which rotate the API key when changed.",
+				},
+			},
+		},
+	}
+}
+
+// apiKeyExpiresAt returns the date and time the API key created at createdAt
+// is rotated, zero when the rotation has no max_age_days.
+func apiKeyExpiresAt(createdAt time.Time, rotation []interface{}) time.Time {
+	if len(rotation) == 0 || rotation[0] == nil {
+		return time.Time{}
+	}
+	maxAgeDays := rotation[0].(map[string]interface{})["max_age_days"].(int)
+	if maxAgeDays <= 0 {
+		return time.Time{}
+	}
+	return createdAt.AddDate(0, 0, maxAgeDays)
+}
+
+// apiKeyExpiresAtString formats the expiry of the API key like its other
+// timestamps, empty when the API key is not rotated on age.
+func apiKeyExpiresAtString(createdAt time.Time, rotation []interface{}) string {
+	expiresAt := apiKeyExpiresAt(createdAt, rotation)
+	if expiresAt.IsZero() {
+		return ""
+	}
+	return expiresAt.UTC().Format(time.RFC3339)
+}
+
+// apiKeyRotationCustomizeDiff replaces the API key once it is older than
+// max_age_days, and leaves expires_at unknown when max_age_days changes.
+func apiKeyRotationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	createdAt, err := time.Parse(time.RFC3339, diff.Get("created_at").(string))
+	if err != nil {
+		// created_at is not known yet, the next refresh sets it
+		return nil
+	}
+	rotation := diff.Get("rotation").([]interface{})
+	expiresAt := apiKeyExpiresAt(createdAt, rotation)
+	if !expiresAt.IsZero() && !time.Now().Before(expiresAt) {
+		if err := diff.SetNewComputed("expires_at"); err != nil {
+			return err
+		}
+		return diff.ForceNew("expires_at")
+	}
+	if apiKeyExpiresAtString(createdAt, rotation) != diff.Get("expires_at").(string) {
+		return diff.SetNewComputed("expires_at")
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIAMAccountSettingsSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMAccountSettingsSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the account.",
+			},
+			"mfa": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MFA trait of the account. Valid values:  * NONE - No MFA trait set  * TOTP - For all non-federated IBMId users  * TOTP4ALL - For all users  * LEVEL1 - Email-based MFA for all users  * LEVEL2 - TOTP-based MFA for all users  * LEVEL3 - U2F MFA for all users.",
+			},
+			"mfa_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether an MFA trait is set for the account.",
+			},
+			"mfa_exempted_users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The iam_id of the users with an MFA requirement different from the one of the account.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"restrict_create_service_id": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether creating a service ID is access controlled.",
+			},
+			"restrict_create_platform_apikey": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether creating platform API keys is access controlled.",
+			},
+			"allowed_ip_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP addresses and subnets from which IAM tokens can be created for the account.",
+			},
+			"ip_restricted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the creation of IAM tokens is restricted to allowed_ip_addresses.",
+			},
+			"session_expiration_in_seconds": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The session expiration in seconds for the account.",
+			},
+			"enabled_regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VPC regions available to the account.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMAccountSettingsSummaryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAccountSettingsOptions := &iamidentityv1.GetAccountSettingsOptions{}
+	getAccountSettingsOptions.SetAccountID(userDetails.UserAccount)
+
+	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
+		return flex.SDKErrorf(err, response, "GetAccountSettingsWithContext failed", "(Data) ibm_iam_account_settings_summary", "read").GetDiag()
+	}
+
+	enabledRegions, err := dataSourceIBMIAMAccountSettingsSummaryRegions(context, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userDetails.UserAccount)
+
+	mfa := flex.StringValue(accountSettingsResponse.Mfa)
+	mfaExemptedUsers := []string{}
+	for _, userMfa := range accountSettingsResponse.UserMfa {
+		if userMfa.IamID != nil && flex.StringValue(userMfa.Mfa) != mfa {
+			mfaExemptedUsers = append(mfaExemptedUsers, *userMfa.IamID)
+		}
+	}
+	allowedIPAddresses := flex.StringValue(accountSettingsResponse.AllowedIPAddresses)
+
+	if err = d.Set("account_id", accountSettingsResponse.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("mfa", mfa); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mfa: %s", err))
+	}
+	if err = d.Set("mfa_enabled", mfa != "" && mfa != "NONE"); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mfa_enabled: %s", err))
+	}
+	if err = d.Set("mfa_exempted_users", mfaExemptedUsers); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mfa_exempted_users: %s", err))
+	}
+	if err = d.Set("restrict_create_service_id", flex.StringValue(accountSettingsResponse.RestrictCreateServiceID) == "RESTRICTED"); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting restrict_create_service_id: %s", err))
+	}
+	if err = d.Set("restrict_create_platform_apikey", flex.StringValue(accountSettingsResponse.RestrictCreatePlatformApikey) == "RESTRICTED"); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting restrict_create_platform_apikey: %s", err))
+	}
+	if err = d.Set("allowed_ip_addresses", allowedIPAddresses); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allowed_ip_addresses: %s", err))
+	}
+	if err = d.Set("ip_restricted", allowedIPAddresses != ""); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ip_restricted: %s", err))
+	}
+	if err = d.Set("session_expiration_in_seconds", accountSettingsResponse.SessionExpirationInSeconds); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting session_expiration_in_seconds: %s", err))
+	}
+	if err = d.Set("enabled_regions", enabledRegions); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enabled_regions: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMIAMAccountSettingsSummaryRegions returns the sorted names of
+// the VPC regions which are available to the account.
+func dataSourceIBMIAMAccountSettingsSummaryRegions(context context.Context, meta interface{}) ([]string, error) {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+	regionCollection, response, err := vpcClient.ListRegionsWithContext(context, &vpcv1.ListRegionsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListRegionsWithContext failed %s\n%s", err, response)
+		return nil, flex.SDKErrorf(err, response, "ListRegionsWithContext failed", "(Data) ibm_iam_account_settings_summary", "read")
+	}
+	regions := []string{}
+	for _, region := range regionCollection.Regions {
+		if region.Name != nil && flex.StringValue(region.Status) == "available" {
+			regions = append(regions, *region.Name)
+		}
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
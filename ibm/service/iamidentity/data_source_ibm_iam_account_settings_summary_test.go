@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccountSettingsSummaryDataSourceBasic(t *testing.T) {
+	acc.VCRTest(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccountSettingsSummaryDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "mfa"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "mfa_enabled"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "mfa_exempted_users.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "restrict_create_service_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "restrict_create_platform_apikey"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "ip_restricted"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "session_expiration_in_seconds"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_settings_summary.summary", "enabled_regions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccountSettingsSummaryDataSourceConfigBasic() string {
+	return `
+		data "ibm_iam_account_settings_summary" "summary" {
+		}
+	`
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamMfaReport() *schema.Resource {
+	// The users of the report have the MFA enrollments of
+	// ibm_iam_user_mfa_enrollments.
+	userMfaEnrollments := DataSourceIBMIamUserMfaEnrollments().Schema
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamMfaReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the account.",
+			},
+			"reference": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reference for the report to be retrieved, 'latest' for the latest report. A new report is created if not set.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAMid of the user who triggered the report.",
+			},
+			"report_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date time at which report is generated. Date is in ISO format.",
+			},
+			"report_duration": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Duration in hours for which the report is generated.",
+			},
+			"non_compliant_iam_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IAM IDs of the users whose enrollments do not comply with the effective MFA requirement.",
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAMid of the user.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"username": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username of the user.",
+						},
+						"email": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email of the user.",
+						},
+						"complies": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The enrollments of the user comply to the effective MFA requirement.",
+						},
+						"effective_mfa_type": userMfaEnrollments["effective_mfa_type"],
+						"id_based_mfa":       userMfaEnrollments["id_based_mfa"],
+						"account_based_mfa":  userMfaEnrollments["account_based_mfa"],
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamMfaReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	reference := d.Get("reference").(string)
+	if reference == "" {
+		createMfaReportOptions := &iamidentityv1.CreateMfaReportOptions{}
+		createMfaReportOptions.SetAccountID(accountID)
+		createMfaReportOptions.SetType("mfa")
+
+		reportReference, response, err := iamIdentityClient.CreateMfaReportWithContext(context, createMfaReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateMfaReportWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateMfaReportWithContext failed %s\n%s", err, response))
+		}
+		reference = *reportReference.Reference
+	}
+
+	getMfaReportOptions := &iamidentityv1.GetMfaReportOptions{}
+	getMfaReportOptions.SetAccountID(accountID)
+	getMfaReportOptions.SetReference(reference)
+
+	// A new report is not found until it is generated.
+	var report *iamidentityv1.ReportMfaEnrollmentStatus
+	err = resource.RetryContext(context, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		var response *core.DetailedResponse
+		var err error
+		report, response, err = iamIdentityClient.GetMfaReportWithContext(context, getMfaReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetMfaReportWithContext failed %s\n%s", err, response)
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("GetMfaReportWithContext failed %s\n%s", err, response))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dataSourceIBMIamMfaReportID(d))
+
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("reference", reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reference: %s", err))
+	}
+	if err = d.Set("created_by", report.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("report_time", report.ReportTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_time: %s", err))
+	}
+	if err = d.Set("report_duration", report.ReportDuration); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_duration: %s", err))
+	}
+
+	users := []map[string]interface{}{}
+	nonCompliantIamIDs := []string{}
+	for _, modelItem := range report.Users {
+		modelMap, err := dataSourceIBMIamMfaReportUserReportMfaEnrollmentStatusToMap(&modelItem)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !modelMap["complies"].(bool) {
+			nonCompliantIamIDs = append(nonCompliantIamIDs, *modelItem.IamID)
+		}
+		users = append(users, modelMap)
+	}
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting users %s", err))
+	}
+	if err = d.Set("non_compliant_iam_ids", nonCompliantIamIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting non_compliant_iam_ids %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMIamMfaReportID returns a reasonable ID for the report.
+func dataSourceIBMIamMfaReportID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMIamMfaReportUserReportMfaEnrollmentStatusToMap(model *iamidentityv1.UserReportMfaEnrollmentStatus) (map[string]interface{}, error) {
+	modelMap := make(map[string]interface{})
+	modelMap["iam_id"] = model.IamID
+	if model.Name != nil {
+		modelMap["name"] = model.Name
+	}
+	if model.Username != nil {
+		modelMap["username"] = model.Username
+	}
+	if model.Email != nil {
+		modelMap["email"] = model.Email
+	}
+
+	// The enrollments comply with the requirement of the effective MFA type.
+	complies := true
+	if model.Enrollments != nil {
+		modelMap["effective_mfa_type"] = model.Enrollments.EffectiveMfaType
+		if model.Enrollments.IDBasedMfa != nil {
+			idBasedMfaMap, err := dataSourceIBMIamUserMfaEnrollmentsIDBasedMfaEnrollmentToMap(model.Enrollments.IDBasedMfa)
+			if err != nil {
+				return modelMap, err
+			}
+			modelMap["id_based_mfa"] = []map[string]interface{}{idBasedMfaMap}
+			if flex.StringValue(model.Enrollments.EffectiveMfaType) == "id_based_mfa" {
+				complies = model.Enrollments.IDBasedMfa.Complies != nil && *model.Enrollments.IDBasedMfa.Complies
+			}
+		}
+		if model.Enrollments.AccountBasedMfa != nil {
+			accountBasedMfaMap, err := dataSourceIBMIamUserMfaEnrollmentsAccountBasedMfaEnrollmentToMap(model.Enrollments.AccountBasedMfa)
+			if err != nil {
+				return modelMap, err
+			}
+			modelMap["account_based_mfa"] = []map[string]interface{}{accountBasedMfaMap}
+			if flex.StringValue(model.Enrollments.EffectiveMfaType) == "account_based_mfa" {
+				complies = model.Enrollments.AccountBasedMfa.Complies != nil && *model.Enrollments.AccountBasedMfa.Complies
+			}
+		}
+	}
+	modelMap["complies"] = complies
+	return modelMap, nil
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIamMfaReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamMfaReportDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_mfa_report.iam_mfa_report", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_mfa_report.iam_mfa_report", "reference"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_mfa_report.iam_mfa_report", "report_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_mfa_report.iam_mfa_report", "users.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamMfaReportDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_iam_mfa_report" "iam_mfa_report" {
+			account_id = "%s"
+		}
+	`, acc.IAMAccountId)
+}
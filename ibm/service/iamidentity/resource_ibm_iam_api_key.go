@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -24,6 +25,8 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 		DeleteContext: resourceIbmIamApiKeyDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: apiKeyRotationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -104,6 +107,12 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Computed:    true,
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
+			"rotation": apiKeyRotationSchema(),
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If rotation sets max_age_days, contains a date time string of the rotation date in ISO format.",
+			},
 		},
 	}
 }
@@ -211,6 +220,9 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 	if err = d.Set("modified_at", apiKey.ModifiedAt.String()); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting modified_at: %s", err))
 	}
+	if err = d.Set("expires_at", apiKeyExpiresAtString(time.Time(*apiKey.CreatedAt), d.Get("rotation").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting expires_at: %s", err))
+	}
 
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -27,6 +28,8 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: apiKeyRotationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -116,6 +119,14 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time Service API Key was modified",
 			},
+
+			"rotation": apiKeyRotationSchema(),
+
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time Service API Key is rotated, when rotation sets max_age_days",
+			},
 		},
 	}
 }
@@ -251,6 +262,9 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.ModifiedAt != nil {
 		d.Set("modified_at", apiKey.ModifiedAt.String())
 	}
+	if apiKey.CreatedAt != nil {
+		d.Set("expires_at", apiKeyExpiresAtString(time.Time(*apiKey.CreatedAt), d.Get("rotation").([]interface{})))
+	}
 
 	return nil
 }
@@ -110,6 +110,37 @@ func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_rotation(t *testing.T) {
+	var apiKeyID string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_service_api_key.testacc_apiKey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.max_age_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					testAccCheckIBMIAMServiceAPIKeyID(resourceName, &apiKeyID, false),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.keepers.version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					testAccCheckIBMIAMServiceAPIKeyID(resourceName, &apiKeyID, true),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMServiceAPIKeyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -230,3 +261,39 @@ func testAccCheckIBMIAMServiceAPIKeyImport(serviceName, name string) string {
 	  	}
 	`, serviceName, name)
 }
+
+func testAccCheckIBMIAMServiceAPIKeyID(n string, apiKeyID *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rotated && rs.Primary.ID == *apiKeyID {
+			return fmt.Errorf("Service API Key %s was not rotated", rs.Primary.ID)
+		}
+		*apiKeyID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, version string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			rotation {
+				max_age_days = 30
+				keepers = {
+					version = "%s"
+				}
+			}
+			lifecycle {
+				create_before_destroy = true
+			}
+		}
+	`, serviceName, name, version)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -60,8 +61,17 @@ func ResourceIBMIAMServiceID() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"locked": {
-				Type:     schema.TypeBool,
-				Computed: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "The serviceID and its API keys cannot be changed or deleted if set to true",
+			},
+
+			"unique_instance_crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the service instances the serviceID is unique to",
 			},
 		},
 	}
@@ -90,6 +100,11 @@ func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceDa
 		createServiceIDOptions.Description = &des
 	}
 
+	if lock, ok := d.GetOk("locked"); ok {
+		elockstr := strconv.FormatBool(lock.(bool))
+		createServiceIDOptions.EntityLock = &elockstr
+	}
+
 	serviceID, resp, err := iamIdentityClient.CreateServiceID(&createServiceIDOptions)
 	if err != nil || serviceID == nil {
 		log.Printf("Error creating serviceID: %s, %s", err, resp)
@@ -136,6 +151,7 @@ func resourceIBMIAMServiceIDRead(context context.Context, d *schema.ResourceData
 	if serviceID.Locked != nil {
 		d.Set("locked", serviceID.Locked)
 	}
+	d.Set("unique_instance_crns", serviceID.UniqueInstanceCrns)
 	return nil
 }
 
@@ -166,6 +182,18 @@ func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceDa
 		hasChange = true
 	}
 
+	// A locked serviceID cannot be updated, so it is unlocked before the
+	// update and locked again after it.
+	o, n := d.GetChange("locked")
+	wasLocked, locked := o.(bool), n.(bool)
+	if wasLocked && (hasChange || !locked) {
+		resp, err := iamIdentityClient.UnlockServiceID(iamIdentityClient.NewUnlockServiceIDOptions(serviceIDUUID))
+		if err != nil {
+			log.Printf("Error unlocking serviceID: %s, %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error unlocking serviceID: %s %s", err, resp))
+		}
+	}
+
 	if hasChange {
 		_, resp, err := iamIdentityClient.UpdateServiceID(&updateServiceIDOptions)
 		if err != nil {
@@ -174,6 +202,14 @@ func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if locked && (hasChange || !wasLocked) {
+		resp, err := iamIdentityClient.LockServiceID(iamIdentityClient.NewLockServiceIDOptions(serviceIDUUID))
+		if err != nil {
+			log.Printf("Error locking serviceID: %s, %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error locking serviceID: %s %s", err, resp))
+		}
+	}
+
 	return resourceIBMIAMServiceIDRead(context, d, meta)
 
 }
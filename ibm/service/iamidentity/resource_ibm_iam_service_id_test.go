@@ -55,6 +55,40 @@ func TestAccIBMIAMServiceID_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceID_locked(t *testing.T) {
+	var conf string
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_service_id.serviceID"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceIDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceIDLocked(name, "ServiceID for test scenario1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceIDExists(resourceName, conf),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceIDLocked(name, "ServiceID for test scenario2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "ServiceID for test scenario2"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceIDLocked(name, "ServiceID for test scenario2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMIAMServiceID_import(t *testing.T) {
 	var conf string
 	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -175,3 +209,14 @@ func testAccCheckIBMIAMServiceIDTag(name string) string {
 		}
 	`, name)
 }
+
+func testAccCheckIBMIAMServiceIDLocked(name, description string, locked bool) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name        = "%s"
+			description = "%s"
+			locked      = %t
+		}
+	`, name, description, locked)
+}
@@ -7,12 +7,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -135,7 +135,7 @@ func resourceIBMIamTrustedProfileIdentityRead(context context.Context, d *schema
 
 	getProfileIdentityOptions := &iamidentityv1.GetProfileIdentityOptions{}
 
-	parts, err := flex.SepIdParts(d.Id(), "/")
+	parts, err := trustedProfileIdentityIDParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,7 +188,7 @@ func resourceIBMIamTrustedProfileIdentityDelete(context context.Context, d *sche
 
 	deleteProfileIdentityOptions := &iamidentityv1.DeleteProfileIdentityOptions{}
 
-	parts, err := flex.SepIdParts(d.Id(), "/")
+	parts, err := trustedProfileIdentityIDParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -199,6 +199,10 @@ func resourceIBMIamTrustedProfileIdentityDelete(context context.Context, d *sche
 
 	response, err := iamIdentityClient.DeleteProfileIdentityWithContext(context, deleteProfileIdentityOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[DEBUG] DeleteProfileIdentityWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteProfileIdentityWithContext failed %s\n%s", err, response))
 	}
@@ -207,3 +211,14 @@ func resourceIBMIamTrustedProfileIdentityDelete(context context.Context, d *sche
 
 	return nil
 }
+
+// trustedProfileIdentityIDParts splits an ID of the form
+// <profile_id>/<identity_type>/<identifier>. The identifier is kept whole, as
+// the CRN of a crn identity contains "/" itself.
+func trustedProfileIdentityIDParts(id string) ([]string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return nil, fmt.Errorf("[ERROR] Invalid ID %s, the ID must be of the form <profile_id>/<identity_type>/<identifier>", id)
+	}
+	return parts, nil
+}
@@ -5,6 +5,7 @@ package iamidentity_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,7 +13,6 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
@@ -119,9 +119,9 @@ func testAccCheckIBMIamTrustedProfileIdentityExists(n string, obj iamidentityv1.
 
 		getProfileIdentityOptions := &iamidentityv1.GetProfileIdentityOptions{}
 
-		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
-		if err != nil {
-			return err
+		parts := strings.SplitN(rs.Primary.ID, "/", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("Invalid ID %s", rs.Primary.ID)
 		}
 
 		getProfileIdentityOptions.SetProfileID(parts[0])
@@ -150,9 +150,9 @@ func testAccCheckIBMIamTrustedProfileIdentityDestroy(s *terraform.State) error {
 
 		getProfileIdentityOptions := &iamidentityv1.GetProfileIdentityOptions{}
 
-		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
-		if err != nil {
-			return err
+		parts := strings.SplitN(rs.Primary.ID, "/", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("Invalid ID %s", rs.Primary.ID)
 		}
 
 		getProfileIdentityOptions.SetProfileID(parts[0])
@@ -233,6 +233,10 @@ func resourceIBMIamTrustedProfileLinkDelete(context context.Context, d *schema.R
 
 	response, err := iamIdentityClient.DeleteLink(deleteLinkOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteLink failed %s\n%s", err, response))
 	}
@@ -37,6 +37,26 @@ func DataSourceIBMIAMAuthorizationPolicies() *schema.Resource {
 				Computed:    true,
 				Description: "Set transactionID for debug",
 			},
+			"source_service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the policies with this source service name",
+			},
+			"target_service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the policies with this target service name",
+			},
+			"source_resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the policies with this source resource instance Id",
+			},
+			"target_resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the policies with this target resource instance Id",
+			},
 			"policies": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -156,14 +176,24 @@ func dataSourceIBMIAMAuthorizationPoliciesRead(d *schema.ResourceData, meta inte
 		listPoliciesOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
-
-	if err != nil || resp == nil {
-		return fmt.Errorf("[ERROR] Error listing authorization policies: %s, %s", err, resp)
+	responseTransactionID := ""
+	policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.PolicyTemplateMetaData, string, error) {
+		if start != "" {
+			listPoliciesOptions.Start = &start
+		}
+		policyList, response, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+		if err != nil || response == nil {
+			return nil, "", fmt.Errorf("[ERROR] Error listing authorization policies: %s, %s", err, response)
+		}
+		if responseTransactionID == "" && len(response.Headers["Transaction-Id"]) > 0 {
+			responseTransactionID = response.Headers["Transaction-Id"][0]
+		}
+		return policyList.Policies, flex.GetNext(policyList.Next), nil
+	})
+	if err != nil {
+		return err
 	}
 
-	policies := policyList.Policies
-
 	authorizationPolicies := make([]map[string]interface{}, 0, len(policies))
 	for _, policy := range policies {
 		roles := make([]string, len(policy.Roles))
@@ -172,6 +202,12 @@ func dataSourceIBMIAMAuthorizationPoliciesRead(d *schema.ResourceData, meta inte
 		}
 		source := policy.Subjects[0]
 		target := policy.Resources[0]
+		if !matchesAuthorizationPolicyFilter(d, "source_service_name", flex.GetSubjectAttribute("serviceName", source)) ||
+			!matchesAuthorizationPolicyFilter(d, "target_service_name", flex.GetResourceAttribute("serviceName", target)) ||
+			!matchesAuthorizationPolicyFilter(d, "source_resource_instance_id", flex.GetSubjectAttribute("serviceInstance", source)) ||
+			!matchesAuthorizationPolicyFilter(d, "target_resource_instance_id", flex.GetResourceAttribute("serviceInstance", target)) {
+			continue
+		}
 
 		p := map[string]interface{}{
 			"id":                          fmt.Sprintf("%s/%s", accountID, *policy.ID),
@@ -195,11 +231,16 @@ func dataSourceIBMIAMAuthorizationPoliciesRead(d *schema.ResourceData, meta inte
 	d.SetId(time.Now().UTC().String())
 	d.Set("account_id", accountID)
 
-	if len(resp.Headers["Transaction-Id"]) > 0 && resp.Headers["Transaction-Id"][0] != "" {
-		d.Set("transaction_id", resp.Headers["Transaction-Id"][0])
+	if responseTransactionID != "" {
+		d.Set("transaction_id", responseTransactionID)
 	}
 
 	d.Set("policies", authorizationPolicies)
 
 	return nil
 }
+
+func matchesAuthorizationPolicyFilter(d *schema.ResourceData, filter string, value *string) bool {
+	expected, ok := d.GetOk(filter)
+	return !ok || (value != nil && *value == expected.(string))
+}
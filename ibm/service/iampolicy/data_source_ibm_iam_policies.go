@@ -0,0 +1,371 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to search the access policies of an account by their subject,
+// roles and resource attributes
+func DataSourceIBMIAMPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The unique ID of an account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"iam_id": {
+				Description:   "Only return the policies of the user, service ID or trusted profile with this IAM ID",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"access_group_id": {
+				Description:   "Only return the policies of the access group with this ID",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"iam_id"},
+			},
+			"role": {
+				Description: "Only return the policies granting the role with this display name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"service_name": {
+				Description: "Only return the policies on the resources of this service",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"resource_attributes": {
+				Description: "Only return the policies with all these resource attributes in the form of 'name=value,name=value....'",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sort": {
+				Description: "Sort query for policies",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"transaction_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set transactionID for debug",
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_attributes": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Subject attributes of the policy definition",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service name of the policy definition",
+									},
+									"resource_instance_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of resource instance of the policy definition",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region of the policy definition",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource type of the policy definition",
+									},
+									"resource": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource of the policy definition",
+									},
+									"resource_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the resource group.",
+									},
+									"service_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service type of the policy definition",
+									},
+									"service_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service group id of the policy definition",
+									},
+									"attributes": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Description: "Set resource attributes in the form of 'name=value,name=value....",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"resource_tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Set access management tags.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+						"rule_conditions": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Rule conditions enforced by the policy",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Key of the condition",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of the condition",
+									},
+									"value": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Value of the condition",
+									},
+									"conditions": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Additional Rule conditions enforced by the policy",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Key of the condition",
+												},
+												"operator": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Operator of the condition",
+												},
+												"value": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+													Description: "Value of the condition",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operator that multiple rule conditions are evaluated over",
+						},
+						"pattern": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pattern rule follows for time-based condition",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	var accountID string
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
+		accountID = account.(string)
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListV2PoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		Type:      core.StringPtr("access"),
+	}
+
+	if v, ok := d.GetOk("iam_id"); ok {
+		listPoliciesOptions.IamID = core.StringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("access_group_id"); ok {
+		listPoliciesOptions.AccessGroupID = core.StringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		listPoliciesOptions.Sort = core.StringPtr(v.(string))
+	}
+
+	if transactionID, ok := d.GetOk("transaction_id"); ok {
+		listPoliciesOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
+	}
+
+	responseTransactionID := ""
+	policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, string, error) {
+		if start != "" {
+			listPoliciesOptions.Start = &start
+		}
+		policyList, response, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+		if err != nil || response == nil {
+			return nil, "", fmt.Errorf("[ERROR] Error listing policies: %s, %s", err, response)
+		}
+		if responseTransactionID == "" && len(response.Headers["Transaction-Id"]) > 0 {
+			responseTransactionID = response.Headers["Transaction-Id"][0]
+		}
+		return policyList.Policies, flex.GetNext(policyList.Next), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	resourceAttributes := d.Get("resource_attributes").(map[string]interface{})
+	if v, ok := d.GetOk("service_name"); ok {
+		resourceAttributes["serviceName"] = v.(string)
+	}
+
+	matchingPolicies := make([]map[string]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		if policy.Resource == nil || !matchesPolicyResourceAttributes(*policy.Resource, resourceAttributes) {
+			continue
+		}
+		roles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
+		if err != nil {
+			return err
+		}
+		if role, ok := d.GetOk("role"); ok && !flex.StringContains(roles, role.(string)) {
+			continue
+		}
+
+		p := map[string]interface{}{
+			"id":            fmt.Sprintf("%s/%s", accountID, *policy.ID),
+			"roles":         roles,
+			"resources":     flex.FlattenV2PolicyResource(*policy.Resource),
+			"resource_tags": flex.FlattenV2PolicyResourceTags(*policy.Resource),
+		}
+		if policy.Subject != nil {
+			subjectAttributes := make(map[string]string, len(policy.Subject.Attributes))
+			for _, a := range policy.Subject.Attributes {
+				subjectAttributes[*a.Key] = fmt.Sprint(a.Value)
+			}
+			p["subject_attributes"] = subjectAttributes
+		}
+		if policy.Description != nil {
+			p["description"] = policy.Description
+		}
+		if policy.Rule != nil {
+			p["rule_conditions"] = flex.FlattenRuleConditions(*policy.Rule.(*iampolicymanagementv1.V2PolicyRule))
+			if len(policy.Rule.(*iampolicymanagementv1.V2PolicyRule).Conditions) > 0 {
+				p["rule_operator"] = policy.Rule.(*iampolicymanagementv1.V2PolicyRule).Operator
+			}
+		}
+		if policy.Pattern != nil {
+			p["pattern"] = policy.Pattern
+		}
+		matchingPolicies = append(matchingPolicies, p)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("account_id", accountID)
+
+	if responseTransactionID != "" {
+		d.Set("transaction_id", responseTransactionID)
+	}
+
+	d.Set("policies", matchingPolicies)
+
+	return nil
+}
+
+// matchesPolicyResourceAttributes reports whether the resource of a policy
+// has all the attributes, compared by their string value.
+func matchesPolicyResourceAttributes(resource iampolicymanagementv1.V2PolicyResource, attributes map[string]interface{}) bool {
+	for key, value := range attributes {
+		found := false
+		for _, a := range resource.Attributes {
+			if *a.Key == key && fmt.Sprint(a.Value) == value.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPoliciesDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPoliciesDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policies.viewer", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policies.viewer", "policies.0.resources.0.service", "kms"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_policies.viewer", "policies.0.subject_attributes.access_group_id", "ibm_iam_access_group.accgrp", "id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policies.manager", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPoliciesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		data "ibm_iam_policies" "viewer" {
+			access_group_id = ibm_iam_access_group_policy.policy.access_group_id
+			role            = "Viewer"
+			service_name    = "kms"
+		}
+
+		data "ibm_iam_policies" "manager" {
+			access_group_id = ibm_iam_access_group_policy.policy.access_group_id
+			role            = "Manager"
+		}
+	`, name)
+}
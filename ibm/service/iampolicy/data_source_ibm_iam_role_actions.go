@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -52,6 +53,12 @@ func DataSourceIBMIAMRoleAction() *schema.Resource {
 				Computed:    true,
 				Description: "List of actions for different services roles",
 			},
+			"all_actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The actions of all the service roles of the service, which can be used in a custom role",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 
@@ -81,6 +88,7 @@ func datasourceIBMIAMRoleActionRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("reader_plus", flex.FlattenActionbyDisplayName("ReaderPlus", serviceRoles))
 	d.Set("writer", flex.FlattenActionbyDisplayName("Writer", serviceRoles))
 	d.Set("actions", flattenRoleActions(serviceRoles))
+	d.Set("all_actions", serviceRoleActions(serviceRoles))
 
 	return nil
 }
@@ -92,3 +100,20 @@ func flattenRoleActions(object []iampolicymanagementv1.Role) map[string]string {
 	}
 	return actions
 }
+
+// serviceRoleActions returns the sorted actions of all the roles, without
+// duplicates.
+func serviceRoleActions(roles []iampolicymanagementv1.Role) []string {
+	seen := make(map[string]bool)
+	actions := make([]string, 0)
+	for _, role := range roles {
+		for _, action := range role.Actions {
+			if !seen[action] {
+				seen[action] = true
+				actions = append(actions, action)
+			}
+		}
+	}
+	sort.Strings(actions)
+	return actions
+}
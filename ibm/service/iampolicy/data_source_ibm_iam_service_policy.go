@@ -274,13 +274,23 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		listPoliciesOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
-
+	responseTransactionID := ""
+	policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, string, error) {
+		if start != "" {
+			listPoliciesOptions.Start = &start
+		}
+		policyList, response, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+		if err != nil || response == nil {
+			return nil, "", fmt.Errorf("Error listing service policies: %s, %s", err, response)
+		}
+		if responseTransactionID == "" && len(response.Headers["Transaction-Id"]) > 0 {
+			responseTransactionID = response.Headers["Transaction-Id"][0]
+		}
+		return policyList.Policies, flex.GetNext(policyList.Next), nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error listing service policies: %s, %s", err, resp)
+		return err
 	}
-
-	policies := policyList.Policies
 	servicePolicies := make([]map[string]interface{}, 0, len(policies))
 	for _, policy := range policies {
 		roles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
@@ -322,8 +332,8 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		iamID := v.(string)
 		d.SetId(iamID)
 	}
-	if len(resp.Headers["Transaction-Id"]) > 0 && resp.Headers["Transaction-Id"][0] != "" {
-		d.Set("transaction_id", resp.Headers["Transaction-Id"][0])
+	if responseTransactionID != "" {
+		d.Set("transaction_id", responseTransactionID)
 	}
 	d.Set("policies", servicePolicies)
 	return nil
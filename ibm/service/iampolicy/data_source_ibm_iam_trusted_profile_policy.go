@@ -313,13 +313,23 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		listPoliciesOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
-
-	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing trusted profile policies: %s, %s", err, resp)
+	responseTransactionID := ""
+	policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, string, error) {
+		if start != "" {
+			listPoliciesOptions.Start = &start
+		}
+		policyList, response, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+		if err != nil || response == nil {
+			return nil, "", fmt.Errorf("Error listing trusted profile policies: %s, %s", err, response)
+		}
+		if responseTransactionID == "" && len(response.Headers["Transaction-Id"]) > 0 {
+			responseTransactionID = response.Headers["Transaction-Id"][0]
+		}
+		return policyList.Policies, flex.GetNext(policyList.Next), nil
+	})
+	if err != nil {
+		return err
 	}
-
-	policies := policyList.Policies
 	profilePolicies := make([]map[string]interface{}, 0, len(policies))
 	for _, policy := range policies {
 		roles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
@@ -365,8 +375,8 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		iamID := v.(string)
 		d.SetId(iamID)
 	}
-	if len(resp.Headers["Transaction-Id"]) > 0 && resp.Headers["Transaction-Id"][0] != "" {
-		d.Set("transaction_id", resp.Headers["Transaction-Id"][0])
+	if responseTransactionID != "" {
+		d.Set("transaction_id", responseTransactionID)
 	}
 	d.Set("policies", profilePolicies)
 	return nil
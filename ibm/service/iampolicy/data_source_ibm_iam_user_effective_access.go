@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	effectiveAccessSourceDirect         = "direct"
+	effectiveAccessSourceAccessGroup    = "access_group"
+	effectiveAccessSourceTrustedProfile = "trusted_profile"
+)
+
+// Data source to resolve the access policies granted to an IAM ID directly,
+// through the access groups it is a member of and through the trusted
+// profiles it can apply
+func DataSourceIBMIAMUserEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMUserEffectiveAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Description: "The IAM ID of the user, service ID or trusted profile",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"account_id": {
+				Description: "The unique ID of an account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"access_group_ids": {
+				Description: "The IDs of the access groups the IAM ID is a member of",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"trusted_profile_ids": {
+				Description: "The IDs of the trusted profiles the IAM ID is an identity of",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Description: "The display names of the roles granted by all the policies",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How the policy applies to the IAM ID: direct, access_group or trusted_profile",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID, access group ID or trusted profile ID the policy is assigned to",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service name of the policy definition",
+									},
+									"resource_instance_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of resource instance of the policy definition",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region of the policy definition",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource type of the policy definition",
+									},
+									"resource": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource of the policy definition",
+									},
+									"resource_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the resource group.",
+									},
+									"service_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service type of the policy definition",
+									},
+									"service_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service group id of the policy definition",
+									},
+									"attributes": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Description: "Set resource attributes in the form of 'name=value,name=value....",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"resource_tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Set access management tags.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMUserEffectiveAccessRead(d *schema.ResourceData, meta interface{}) error {
+	var accountID string
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+
+	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
+		accountID = account.(string)
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = userDetails.UserAccount
+	}
+	iamID := d.Get("iam_id").(string)
+
+	// Access groups the IAM ID is a member of
+	accessGroupIDs := make([]string, 0)
+	var offset, limit int64 = 0, 100
+	for {
+		listAccessGroupsOptions := &iamaccessgroupsv2.ListAccessGroupsOptions{
+			AccountID: &accountID,
+			IamID:     &iamID,
+			Offset:    &offset,
+			Limit:     &limit,
+		}
+		groups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+		}
+		for _, group := range groups.Groups {
+			accessGroupIDs = append(accessGroupIDs, *group.ID)
+		}
+		offset += limit
+		if len(groups.Groups) == 0 || int(offset) >= flex.IntValue(groups.TotalCount) {
+			break
+		}
+	}
+
+	// Trusted profiles the IAM ID is an identity of
+	trustedProfiles := make(map[string]string)
+	trustedProfileIDs := make([]string, 0)
+	profileStart := ""
+	for {
+		listProfilesOptions := &iamidentityv1.ListProfilesOptions{
+			AccountID: &accountID,
+			Pagesize:  &limit,
+		}
+		if profileStart != "" {
+			listProfilesOptions.Pagetoken = &profileStart
+		}
+		profiles, resp, err := iamIdentityClient.ListProfiles(listProfilesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp)
+		}
+		for _, profile := range profiles.Profiles {
+			getProfileIdentitiesOptions := &iamidentityv1.GetProfileIdentitiesOptions{}
+			getProfileIdentitiesOptions.SetProfileID(*profile.ID)
+			identities, resp, err := iamIdentityClient.GetProfileIdentities(getProfileIdentitiesOptions)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					continue
+				}
+				return fmt.Errorf("[ERROR] Error retrieving identities of Trusted Profile %s: %s %s", *profile.ID, err, resp)
+			}
+			for _, identity := range identities.Identities {
+				if flex.StringValue(identity.IamID) == iamID {
+					trustedProfiles[*profile.ID] = *profile.IamID
+					trustedProfileIDs = append(trustedProfileIDs, *profile.ID)
+					break
+				}
+			}
+		}
+		profileStart = flex.GetNextIAM(profiles.Next)
+		if profileStart == "" {
+			break
+		}
+	}
+
+	effectivePolicies := make([]map[string]interface{}, 0)
+	effectiveRoles := make([]string, 0)
+	appendPolicies := func(source, sourceID string, listPoliciesOptions *iampolicymanagementv1.ListV2PoliciesOptions) error {
+		listPoliciesOptions.AccountID = core.StringPtr(accountID)
+		listPoliciesOptions.Type = core.StringPtr("access")
+		policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, string, error) {
+			if start != "" {
+				listPoliciesOptions.Start = &start
+			}
+			policyList, response, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+			if err != nil || response == nil {
+				return nil, "", fmt.Errorf("[ERROR] Error listing policies: %s, %s", err, response)
+			}
+			return policyList.Policies, flex.GetNext(policyList.Next), nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range policies {
+			roles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
+			if err != nil {
+				return err
+			}
+			for _, role := range roles {
+				if !flex.StringContains(effectiveRoles, role) {
+					effectiveRoles = append(effectiveRoles, role)
+				}
+			}
+			p := map[string]interface{}{
+				"id":        fmt.Sprintf("%s/%s", accountID, *policy.ID),
+				"source":    source,
+				"source_id": sourceID,
+				"roles":     roles,
+			}
+			if policy.Resource != nil {
+				p["resources"] = flex.FlattenV2PolicyResource(*policy.Resource)
+				p["resource_tags"] = flex.FlattenV2PolicyResourceTags(*policy.Resource)
+			}
+			if policy.Description != nil {
+				p["description"] = policy.Description
+			}
+			effectivePolicies = append(effectivePolicies, p)
+		}
+		return nil
+	}
+
+	if err := appendPolicies(effectiveAccessSourceDirect, iamID, &iampolicymanagementv1.ListV2PoliciesOptions{IamID: core.StringPtr(iamID)}); err != nil {
+		return err
+	}
+	for _, accessGroupID := range accessGroupIDs {
+		if err := appendPolicies(effectiveAccessSourceAccessGroup, accessGroupID, &iampolicymanagementv1.ListV2PoliciesOptions{AccessGroupID: core.StringPtr(accessGroupID)}); err != nil {
+			return err
+		}
+	}
+	for _, profileID := range trustedProfileIDs {
+		if err := appendPolicies(effectiveAccessSourceTrustedProfile, profileID, &iampolicymanagementv1.ListV2PoliciesOptions{IamID: core.StringPtr(trustedProfiles[profileID])}); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("account_id", accountID)
+	d.Set("access_group_ids", accessGroupIDs)
+	d.Set("trusted_profile_ids", trustedProfileIDs)
+	d.Set("roles", effectiveRoles)
+	d.Set("policies", effectivePolicies)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMUserEffectiveAccessDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserEffectiveAccessDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_user_effective_access.access", "access_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_user_effective_access.access", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_iam_user_effective_access.access", "policies.0.source", "direct"),
+					resource.TestCheckResourceAttr("data.ibm_iam_user_effective_access.access", "policies.1.source", "access_group"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_user_effective_access.access", "policies.1.source_id", "ibm_iam_access_group.accgrp", "id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_user_effective_access.access", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMUserEffectiveAccessDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_service_policy" "policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles          = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group_members" "accgrpmem" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			iam_service_ids = [ibm_iam_service_id.serviceID.id]
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Reader"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		data "ibm_iam_user_effective_access" "access" {
+			iam_id     = ibm_iam_service_id.serviceID.iam_id
+			depends_on = [ibm_iam_service_policy.policy, ibm_iam_access_group_members.accgrpmem, ibm_iam_access_group_policy.policy]
+		}
+	`, name)
+}
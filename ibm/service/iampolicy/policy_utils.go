@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyRuleCustomizeDiff replaces an access policy created without
+// rule_conditions and pattern when they are added. Such a policy is a v1
+// policy, which the v1 API can't update with conditions.
+func policyRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldConditions, newConditions := diff.GetChange("rule_conditions")
+	oldPattern, newPattern := diff.GetChange("pattern")
+	hadRule := oldConditions.(*schema.Set).Len() > 0 || oldPattern.(string) != ""
+	hasRule := newConditions.(*schema.Set).Len() > 0 || newPattern.(string) != ""
+	if hadRule || !hasRule {
+		return nil
+	}
+	if diff.HasChange("rule_conditions") {
+		return diff.ForceNew("rule_conditions")
+	}
+	return diff.ForceNew("pattern")
+}
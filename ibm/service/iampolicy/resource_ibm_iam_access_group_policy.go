@@ -35,6 +35,7 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: policyRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -503,12 +504,8 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("resource_tags", flex.FlattenV2PolicyResourceTags(*accessGroupPolicy.Resource))
 	}
 
-	if _, ok := d.GetOk("rule_conditions"); ok {
-		d.Set("rule_conditions", flex.FlattenRuleConditions(*accessGroupPolicy.Rule.(*iampolicymanagementv1.V2PolicyRule)))
-	}
-
-	if _, ok := d.GetOk("rule_operator"); ok {
-		d.Set("rule_operator", *accessGroupPolicy.Rule.(*iampolicymanagementv1.V2PolicyRule).Operator)
+	if err = flex.SetV2PolicyRule(d, *accessGroupPolicy); err != nil {
+		return err
 	}
 
 	if (&iampolicymanagementv1.V2PolicyResource{}) != accessGroupPolicy.Resource {
@@ -221,6 +221,29 @@ func ResourceIBMIAMAuthorizationPolicyValidator() *validate.ResourceValidator {
 	return &iBMIAMAuthorizationPolicyValidator
 }
 func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions, err := expandAuthorizationPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+
+	authPolicy, resp, err := iampapClient.CreateV2Policy(createPolicyOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+	}
+
+	d.SetId(*authPolicy.ID)
+
+	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+}
+
+// expandAuthorizationPolicy returns the options creating the authorization
+// policy described by the arguments of d.
+func expandAuthorizationPolicy(d *schema.ResourceData, meta interface{}) (*iampolicymanagementv1.CreateV2PolicyOptions, error) {
 
 	var sourceServiceName, targetServiceName string
 	policyType := "authorization"
@@ -229,12 +252,12 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// check subject_attributes exists
@@ -262,7 +285,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 				} else if value == "false" {
 					resourceValue = false
 				} else {
-					return fmt.Errorf("[ERROR] Only values \"true\" and \"false\" are allowed when operator is \"stringExists\". Received %s.", value)
+					return nil, fmt.Errorf("[ERROR] Only values \"true\" and \"false\" are allowed when operator is \"stringExists\". Received %s.", value)
 				}
 				at := iampolicymanagementv1.V2PolicySubjectAttribute{
 					Key:      &name,
@@ -365,7 +388,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 				} else if value == "false" {
 					resourceValue = false
 				} else {
-					return fmt.Errorf("[ERROR] When operator equals stringExists, value should be either \"true\" or \"false\", instead of %s", value)
+					return nil, fmt.Errorf("[ERROR] When operator equals stringExists, value should be either \"true\" or \"false\", instead of %s", value)
 				}
 				at := iampolicymanagementv1.V2PolicyResourceAttribute{
 					Key:      &name,
@@ -440,14 +463,14 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
 
 	if err != nil || roleList == nil {
-		return fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+		return nil, fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
 	}
 
 	policyRoles := flex.MapRoleListToPolicyRoles(*roleList)
 	roles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(d.Get("roles").([]interface{})), policyRoles)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	policyGrant := &iampolicymanagementv1.Grant{
@@ -474,14 +497,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 		createPolicyOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
 	}
 
-	authPolicy, resp, err := iampapClient.CreateV2Policy(createPolicyOptions)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
-	}
-
-	d.SetId(*authPolicy.ID)
-
-	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+	return createPolicyOptions, nil
 }
 
 func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
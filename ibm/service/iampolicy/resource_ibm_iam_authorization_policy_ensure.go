@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAuthorizationPolicyEnsure is ibm_iam_authorization_policy
+// reusing the existing policy with the same source, target and roles instead
+// of failing with a policy conflict. Only the policy created by the resource
+// is deleted with it, a reused policy is owned by someone else.
+func ResourceIBMIAMAuthorizationPolicyEnsure() *schema.Resource {
+	authorizationPolicy := ResourceIBMIAMAuthorizationPolicy()
+	authorizationPolicy.Schema["reused"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether an existing authorization policy was reused instead of being created",
+	}
+	// The roles of a reused policy can't be changed, a new policy is ensured
+	// instead.
+	authorizationPolicy.Schema["roles"].ForceNew = true
+
+	return &schema.Resource{
+		Create: resourceIBMIAMAuthorizationPolicyEnsureCreate,
+		Read:   resourceIBMIAMAuthorizationPolicyRead,
+		Update: resourceIBMIAMAuthorizationPolicyUpdate,
+		Delete: resourceIBMIAMAuthorizationPolicyEnsureDelete,
+		Exists: resourceIBMIAMAuthorizationPolicyExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMIAMAuthorizationPolicyEnsureImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: authorizationPolicy.Schema,
+	}
+}
+
+func resourceIBMIAMAuthorizationPolicyEnsureCreate(d *schema.ResourceData, meta interface{}) error {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions, err := expandAuthorizationPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+
+	key := authorizationPolicyKey(createPolicyOptions.Subject.Attributes, createPolicyOptions.Resource.Attributes)
+	conns.IbmMutexKV.Lock(key)
+	defer conns.IbmMutexKV.Unlock(key)
+
+	existing, err := findAuthorizationPolicy(iampapClient, userDetails.UserAccount, key)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		authPolicy, resp, err := iampapClient.CreateV2Policy(createPolicyOptions)
+		if err == nil {
+			d.SetId(*authPolicy.ID)
+			d.Set("reused", false)
+			return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+		}
+		if resp == nil || resp.StatusCode != 409 {
+			return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+		}
+		// The policy was just created by another resource and isn't listed yet.
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			policy, err := findAuthorizationPolicy(iampapClient, userDetails.UserAccount, key)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			existing = policy
+			if existing == nil {
+				return resource.RetryableError(fmt.Errorf("[ERROR] The conflicting authorization policy isn't listed yet"))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !sameRoles(existing.Control.Grant.Roles, createPolicyOptions.Control.Grant.Roles) {
+		return fmt.Errorf("[ERROR] The authorization policy %s has the same source and target but different roles, "+
+			"import it in an ibm_iam_authorization_policy resource to manage its roles", *existing.ID)
+	}
+	log.Printf("[INFO] Reusing the existing authorization policy %s", *existing.ID)
+	d.SetId(*existing.ID)
+	d.Set("reused", true)
+	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+}
+
+// resourceIBMIAMAuthorizationPolicyEnsureDelete deletes the policy created by
+// the resource and keeps a reused one.
+func resourceIBMIAMAuthorizationPolicyEnsureDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("reused").(bool) {
+		return resourceIBMIAMAuthorizationPolicyDelete(d, meta)
+	}
+	log.Printf("[INFO] Keeping the reused authorization policy %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMIAMAuthorizationPolicyEnsureImport imports the policy as reused,
+// so that it is kept when the resource is destroyed.
+func resourceIBMIAMAuthorizationPolicyEnsureImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("reused", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// findAuthorizationPolicy returns the active authorization policy of the
+// account with the subject and resource identified by key, nil if none.
+func findAuthorizationPolicy(iampapClient *iampolicymanagementv1.IamPolicyManagementV1, accountID, key string) (*iampolicymanagementv1.V2PolicyTemplateMetaData, error) {
+	listPoliciesOptions := &iampolicymanagementv1.ListV2PoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		Type:      core.StringPtr("authorization"),
+	}
+	policies, err := flex.GetAllPages(func(start string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, string, error) {
+		if start != "" {
+			listPoliciesOptions.Start = &start
+		}
+		policyList, response, err := iampapClient.ListV2Policies(listPoliciesOptions)
+		if err != nil || policyList == nil {
+			return nil, "", fmt.Errorf("[ERROR] Error listing authorization policies: %s, %s", err, response)
+		}
+		return policyList.Policies, flex.GetNext(policyList.Next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, policy := range policies {
+		if policy.Subject == nil || policy.Resource == nil || policy.Control == nil || policy.Control.Grant == nil {
+			continue
+		}
+		if policy.State != nil && *policy.State == "deleted" {
+			continue
+		}
+		if authorizationPolicyKey(policy.Subject.Attributes, policy.Resource.Attributes) == key {
+			return &policies[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// authorizationPolicyKey identifies an authorization policy by its subject and
+// resource attributes, regardless of their order.
+func authorizationPolicyKey(subject []iampolicymanagementv1.V2PolicySubjectAttribute, resource []iampolicymanagementv1.V2PolicyResourceAttribute) string {
+	subjectAttributes := make([]string, 0, len(subject))
+	for _, attribute := range subject {
+		subjectAttributes = append(subjectAttributes, policyAttributeKey(attribute.Key, attribute.Operator, attribute.Value))
+	}
+	resourceAttributes := make([]string, 0, len(resource))
+	for _, attribute := range resource {
+		resourceAttributes = append(resourceAttributes, policyAttributeKey(attribute.Key, attribute.Operator, attribute.Value))
+	}
+	sort.Strings(subjectAttributes)
+	sort.Strings(resourceAttributes)
+	return fmt.Sprintf("authorization:%s->%s", strings.Join(subjectAttributes, ","), strings.Join(resourceAttributes, ","))
+}
+
+func policyAttributeKey(key, operator *string, value interface{}) string {
+	var v string
+	switch value := value.(type) {
+	case *string:
+		v = *value
+	case *bool:
+		v = strconv.FormatBool(*value)
+	default:
+		v = fmt.Sprintf("%v", value)
+	}
+	return fmt.Sprintf("%s %s %s", flex.StringValue(key), flex.StringValue(operator), v)
+}
+
+func sameRoles(existing, roles []iampolicymanagementv1.Roles) bool {
+	if len(existing) != len(roles) {
+		return false
+	}
+	roleIDs := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		roleIDs[flex.StringValue(role.RoleID)] = true
+	}
+	for _, role := range existing {
+		if !roleIDs[flex.StringValue(role.RoleID)] {
+			return false
+		}
+	}
+	return true
+}
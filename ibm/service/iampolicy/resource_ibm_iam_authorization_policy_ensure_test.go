@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAuthorizationPolicyEnsure_Reuse(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAuthorizationPolicyEnsureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAuthorizationPolicyEnsureReuse(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_authorization_policy_ensure.policy", "reused", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_authorization_policy_ensure.same_policy", "reused", "true"),
+					resource.TestCheckResourceAttrPair("ibm_iam_authorization_policy_ensure.same_policy", "id",
+						"ibm_iam_authorization_policy_ensure.policy", "id"),
+					resource.TestCheckResourceAttr("ibm_iam_authorization_policy_ensure.same_policy", "target_service_name", "kms"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAuthorizationPolicyEnsureRoles(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_authorization_policy_ensure.policy", "reused", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_authorization_policy_ensure.policy", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMIAMAuthorizationPolicyEnsureDestroy checks that the policy
+// created by the resource is deleted with it.
+func testAccCheckIBMIAMAuthorizationPolicyEnsureDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_authorization_policy_ensure" {
+			continue
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			rs.Primary.ID,
+		)
+		policy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err == nil && *policy.State != "deleted" {
+			return fmt.Errorf("Authorization policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAuthorizationPolicyEnsureReuse() string {
+	return `
+	resource "ibm_iam_authorization_policy_ensure" "policy" {
+		source_service_name = "cloud-object-storage"
+		target_service_name = "kms"
+		roles               = ["Reader"]
+	}
+
+	resource "ibm_iam_authorization_policy_ensure" "same_policy" {
+		source_service_name = "cloud-object-storage"
+		target_service_name = "kms"
+		roles               = ["Reader"]
+		depends_on          = [ibm_iam_authorization_policy_ensure.policy]
+	}
+	`
+}
+
+func testAccCheckIBMIAMAuthorizationPolicyEnsureRoles() string {
+	return `
+	resource "ibm_iam_authorization_policy_ensure" "policy" {
+		source_service_name = "cloud-object-storage"
+		target_service_name = "kms"
+		roles               = ["Reader", "Authorization Delegator"]
+	}
+	`
+}
@@ -4,7 +4,9 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -31,6 +33,8 @@ func ResourceIBMIAMCustomRole() *schema.Resource {
 		Exists:   resourceIBMIAMCustomRoleExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMIAMCustomRoleActionsCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			iamCRDisplayName: {
 				Type:         schema.TypeString,
@@ -123,6 +127,45 @@ func ResourceIBMIAMCustomRoleValidator() *validate.ResourceValidator {
 	return &ibmIAMCustomRoleResourceValidator
 }
 
+// resourceIBMIAMCustomRoleActionsCustomizeDiff checks at plan time that the
+// actions are actions of the service roles of the service, instead of
+// failing on apply.
+func resourceIBMIAMCustomRoleActionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(iamCRServiceName) || !diff.NewValueKnown(iamCRActions) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(iamCRActions) {
+		return nil
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	serviceName := diff.Get(iamCRServiceName).(string)
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		ServiceName: &serviceName,
+	}
+	roleList, response, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		log.Printf("[WARN] Not checking the actions of the custom role, listing the roles of %s failed: %s\n%s", serviceName, err, response)
+		return nil
+	}
+	validActions := serviceRoleActions(roleList.ServiceRoles)
+	if len(validActions) == 0 {
+		return nil
+	}
+	var unknownActions []string
+	for _, action := range flex.ExpandStringList(diff.Get(iamCRActions).([]interface{})) {
+		if !flex.StringContains(validActions, action) {
+			unknownActions = append(unknownActions, action)
+		}
+	}
+	if len(unknownActions) > 0 {
+		return fmt.Errorf("[ERROR] The actions %s aren't actions of the service %s, the ibm_iam_role_actions data source lists them in all_actions", strings.Join(unknownActions, ", "), serviceName)
+	}
+	return nil
+}
+
 func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
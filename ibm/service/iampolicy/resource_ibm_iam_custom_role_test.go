@@ -5,6 +5,7 @@ package iampolicy_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -111,6 +112,24 @@ func TestAccIBMIAMCustomRole_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMCustomRole_unknownAction(t *testing.T) {
+	name := fmt.Sprintf("Terraform%d", acctest.RandIntRange(10, 100))
+	displayName := fmt.Sprintf("Terraform%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMCustomRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIAMCustomRoleUnknownAction(name, displayName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("kms.secrets.unknown aren't actions of the service kms"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMCustomRoleDestroy(s *terraform.State) error {
 	roleClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -217,3 +236,15 @@ func testAccCheckIBMIAMCustomRoleMultipleAction(name, displayName string) string
 	  }
 	`, name, displayName)
 }
+
+func testAccCheckIBMIAMCustomRoleUnknownAction(name, displayName string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_custom_role" "customrole" {
+		name         = "%s"
+		display_name = "%s"
+		service      = "kms"
+		actions      = ["kms.secrets.rotate", "kms.secrets.unknown"]
+	}
+	`, name, displayName)
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -17,6 +19,12 @@ import (
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
+const (
+	policyAssignmentInProgress = "in_progress"
+	policyAssignmentComplete   = "complete"
+	policyAssignmentFailed     = "failed"
+)
+
 func ResourceIBMIAMPolicyAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPolicyAssignmentCreate,
@@ -25,6 +33,12 @@ func ResourceIBMIAMPolicyAssignment() *schema.Resource {
 		DeleteContext: resourceIBMPolicyAssignmentDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:        schema.TypeString,
@@ -40,6 +54,7 @@ func ResourceIBMIAMPolicyAssignment() *schema.Resource {
 			"target": {
 				Type:        schema.TypeMap,
 				Required:    true,
+				ForceNew:    true,
 				Description: "assignment target details",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -56,6 +71,7 @@ func ResourceIBMIAMPolicyAssignment() *schema.Resource {
 						"id": {
 							Type:        schema.TypeString,
 							Required:    true,
+							ForceNew:    true,
 							Description: "policy template id.",
 						},
 						"version": {
@@ -278,6 +294,11 @@ func resourceIBMPolicyAssignmentCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*policyAssignmentV1Collection.Assignments[0].ID)
 
+	_, err = waitForPolicyAssignment(d.Timeout(schema.TimeoutCreate), meta, d, isPolicyTemplateAssigned)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMPolicyAssignmentRead(context, d, meta)
 }
 
@@ -295,8 +316,6 @@ func resourceIBMPolicyAssignmentRead(context context.Context, d *schema.Resource
 	}
 
 	assignmentResponse, response, err := iamPolicyManagementClient.GetPolicyAssignmentWithContext(context, getPolicyAssignmentOptions)
-
-	assignmentDetails := assignmentResponse.(*iampolicymanagementv1.GetPolicyAssignmentResponse)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -306,6 +325,7 @@ func resourceIBMPolicyAssignmentRead(context context.Context, d *schema.Resource
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	assignmentDetails := assignmentResponse.(*iampolicymanagementv1.GetPolicyAssignmentResponse)
 
 	targetMap, err := ResourceIBMPolicyAssignmentAssignmentTargetDetailsToMap(assignmentDetails.Target)
 	if err != nil {
@@ -416,6 +436,11 @@ func resourceIBMPolicyAssignmentUpdate(context context.Context, d *schema.Resour
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
+
+		_, err = waitForPolicyAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isPolicyTemplateAssigned)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMPolicyAssignmentRead(context, d, meta)
@@ -433,18 +458,98 @@ func resourceIBMPolicyAssignmentDelete(context context.Context, d *schema.Resour
 
 	deletePolicyAssignmentOptions.SetAssignmentID(d.Id())
 
-	_, err = iamPolicyManagementClient.DeletePolicyAssignmentWithContext(context, deletePolicyAssignmentOptions)
+	response, err := iamPolicyManagementClient.DeletePolicyAssignmentWithContext(context, deletePolicyAssignmentOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeletePolicyAssignmentWithContext failed: %s", err.Error()), "ibm_policy_assignment", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
 
+	_, err = waitForPolicyAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isPolicyTemplateAssignmentDeleted)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 
 	return nil
 }
 
+// waitForPolicyAssignment waits until the policies of the assignment are
+// created, updated or removed in the target account.
+func waitForPolicyAssignment(timeout time.Duration, meta interface{}, d *schema.ResourceData, refreshFn func(string, interface{}) resource.StateRefreshFunc) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{policyAssignmentInProgress},
+		Target:       []string{policyAssignmentComplete},
+		Refresh:      refreshFn(d.Id(), meta),
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isPolicyTemplateAssigned(id string, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return nil, policyAssignmentFailed, err
+		}
+
+		getPolicyAssignmentOptions := &iampolicymanagementv1.GetPolicyAssignmentOptions{
+			AssignmentID: core.StringPtr(id),
+			Version:      core.StringPtr("1.0"),
+		}
+		assignmentResponse, response, err := iamPolicyManagementClient.GetPolicyAssignment(getPolicyAssignmentOptions)
+		if err != nil {
+			return nil, policyAssignmentFailed, fmt.Errorf("[ERROR] Error getting policy assignment %s: %s\n%s", id, err, response)
+		}
+		assignment := assignmentResponse.(*iampolicymanagementv1.GetPolicyAssignmentResponse)
+		if assignment.Status == nil {
+			return assignment, policyAssignmentInProgress, nil
+		}
+
+		switch *assignment.Status {
+		case "accepted", "in_progress":
+			log.Printf("Policy assignment %s still in progress\n", id)
+			return assignment, policyAssignmentInProgress, nil
+		case "succeeded":
+			return assignment, policyAssignmentComplete, nil
+		case "failed", "succeed_with_errors":
+			return assignment, policyAssignmentFailed, fmt.Errorf("[ERROR] The policy assignment %s completed with a '%s' status. Please check the resources of the assignment for detailed errors", id, *assignment.Status)
+		}
+		return assignment, policyAssignmentFailed, fmt.Errorf("[ERROR] Unexpected status %s reached for policy assignment %s", *assignment.Status, id)
+	}
+}
+
+func isPolicyTemplateAssignmentDeleted(id string, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return nil, policyAssignmentFailed, err
+		}
+
+		getPolicyAssignmentOptions := &iampolicymanagementv1.GetPolicyAssignmentOptions{
+			AssignmentID: core.StringPtr(id),
+			Version:      core.StringPtr("1.0"),
+		}
+		assignment, response, err := iamPolicyManagementClient.GetPolicyAssignment(getPolicyAssignmentOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return id, policyAssignmentComplete, nil
+			}
+			return nil, policyAssignmentFailed, fmt.Errorf("[ERROR] Error getting policy assignment %s: %s\n%s", id, err, response)
+		}
+		log.Printf("Policy assignment %s removal still in progress\n", id)
+		return assignment, policyAssignmentInProgress, nil
+	}
+}
+
 func ResourceIBMPolicyAssignmentMapToAssignmentTargetDetails(modelMap map[string]interface{}) (*iampolicymanagementv1.AssignmentTargetDetails, error) {
 	model := &iampolicymanagementv1.AssignmentTargetDetails{}
 	if modelMap["type"] != nil && modelMap["type"].(string) != "" {
@@ -36,6 +36,7 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: policyRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
@@ -535,12 +536,8 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("resource_tags", flex.FlattenV2PolicyResourceTags(*servicePolicy.Resource))
 	}
 
-	if _, ok := d.GetOk("rule_conditions"); ok {
-		d.Set("rule_conditions", flex.FlattenRuleConditions(*servicePolicy.Rule.(*iampolicymanagementv1.V2PolicyRule)))
-	}
-
-	if _, ok := d.GetOk("rule_operator"); ok {
-		d.Set("rule_operator", *servicePolicy.Rule.(*iampolicymanagementv1.V2PolicyRule).Operator)
+	if err = flex.SetV2PolicyRule(d, *servicePolicy); err != nil {
+		return err
 	}
 
 	if (&iampolicymanagementv1.V2PolicyResource{}) != servicePolicy.Resource {
@@ -36,6 +36,7 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: policyRuleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
@@ -206,6 +207,11 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"rule_conditions": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -521,6 +527,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 	roles, err := flex.GetRoleNamesFromPolicyResponse(*trustedProfilePolicy, d, meta)
 	d.Set("roles", roles)
+	d.Set("version", res.Headers.Get("ETag"))
 
 	if _, ok := d.GetOk("resources"); ok {
 		d.Set("resources", flex.FlattenV2PolicyResource(*trustedProfilePolicy.Resource))
@@ -533,12 +540,8 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		d.Set("resource_tags", flex.FlattenV2PolicyResourceTags(*trustedProfilePolicy.Resource))
 	}
 
-	if _, ok := d.GetOk("rule_conditions"); ok {
-		d.Set("rule_conditions", flex.FlattenRuleConditions(*trustedProfilePolicy.Rule.(*iampolicymanagementv1.V2PolicyRule)))
-	}
-
-	if _, ok := d.GetOk("rule_operator"); ok {
-		d.Set("rule_operator", *trustedProfilePolicy.Rule.(*iampolicymanagementv1.V2PolicyRule).Operator)
+	if err = flex.SetV2PolicyRule(d, *trustedProfilePolicy); err != nil {
+		return err
 	}
 
 	if (&iampolicymanagementv1.V2PolicyResource{}) != trustedProfilePolicy.Resource {
@@ -35,6 +35,7 @@ func TestAccIBMIAMTrustedProfilePolicyBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "tags.#", "1"),
 					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "1"),
 					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "description", "IAM Trusted Profile Policy Creation for test scenario"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_policy.policy", "version"),
 				),
 			},
 			{
@@ -138,7 +138,6 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 		Read:     resourceIBMIAMGetUsers,
 		Update:   resourceIBMIAMUpdateUserProfile,
 		Delete:   resourceIBMIAMRemoveUser,
-		Exists:   resourceIBMIAMGetUserProfileExists,
 		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 
@@ -155,6 +154,44 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"resend_trigger": {
+				Description: "Any value that resends the pending invitations of the users invited by the resource when it changes",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"managed_users": {
+				Description: "List of users invited by the resource, only these users are removed from the account",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+			},
+			"pending_users": {
+				Description: "List of users whose invitation is pending",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_access_groups": {
+				Description: "access group ids to associate a single inviting user, in addition to access_groups",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Description: "ibm id or email of the user",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"access_groups": {
+							Description: "access group ids to associate the user",
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"iam_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -483,69 +520,37 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 }
 
 func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
-	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
-	if err != nil {
-		return err
-	}
-	client := userManagement.UserInvite()
-
 	usersSet := d.Get("users").(*schema.Set)
 	usersList := flex.FlattenUsersSet(usersSet)
-	users := make([]v2.User, 0)
-	for _, user := range usersList {
-		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-	}
-	if len(users) == 0 {
+	if len(usersList) == 0 {
 		return fmt.Errorf("[ERROR] Users email not provided")
 	}
-	var accessGroups = make([]string, 0)
-	if data, ok := d.GetOk("access_groups"); ok {
-		for _, accessGroup := range data.([]interface{}) {
-			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-		}
-	}
 
-	var accessPolicies []v2.UserPolicy
-	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-		if err != nil {
-			log.Println("IAM Acess policy: ", err.Error())
-			return err
-		}
-	}
-
-	inviteUserPayload := v2.UserInvite{}
-	log.Println(inviteUserPayload)
-	inviteUserPayload.Users = users
-	if len(accessGroups) != 0 {
-		inviteUserPayload.AccessGroup = accessGroups
-	}
-	if len(accessPolicies) != 0 {
-		inviteUserPayload.IAMPolicy = accessPolicies
-	}
-
-	if infraPermissions := getInfraPermissions(d, meta); len(infraPermissions) != 0 {
-		inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-	}
-	orgRoles, err := getCloudFoundryRoles(d, meta)
+	accountID, err := getAccountID(d, meta)
 	if err != nil {
 		return err
 	}
-	if len(orgRoles) != 0 {
-		inviteUserPayload.OrganizationRoles = orgRoles
-	}
 
-	accountID, err := getAccountID(d, meta)
+	// The users already in the account are not invited again, and as they
+	// are not managed by the resource they are never removed by it.
+	accountUsers, _, err := getAccountUsers(meta, accountID)
 	if err != nil {
 		return err
 	}
 
-	_, InviteUserError := client.InviteUsers(accountID, inviteUserPayload)
-	if InviteUserError != nil {
-		return InviteUserError
+	invited, err := inviteUsers(d, meta, accountID, usersNotInAccount(usersList, accountUsers))
+	if err != nil {
+		// The users invited before the failure are kept in the state, so that
+		// they are removed with the tainted resource.
+		if len(invited) > 0 {
+			d.SetId(time.Now().UTC().String())
+			d.Set("managed_users", invited)
+		}
+		return err
 	}
 	d.SetId(time.Now().UTC().String())
-	return resourceIBMIAMUpdateUserProfile(d, meta)
+	d.Set("managed_users", invited)
+	return resourceIBMIAMGetUsers(d, meta)
 }
 
 func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
@@ -570,14 +575,42 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	users := make([]string, 0)
-	invitedUsers := make([]map[string]interface{}, 0, len(res))
 
+	// The users no longer in the account, removed or whose invitation failed,
+	// are left out of the state so that the next apply invites them again.
+	accountUsers := make(map[string]bool, len(res))
+	userStates := make(map[string]string, len(res))
 	for _, user := range res {
+		accountUsers[strings.ToLower(user.Email)] = true
+		userStates[strings.ToLower(user.Email)] = user.State
+	}
+	configuredUsers := flex.FlattenUsersSet(d.Get("users").(*schema.Set))
+	users := make([]string, 0, len(configuredUsers))
+	for _, user := range configuredUsers {
+		if accountUsers[strings.ToLower(user)] {
+			users = append(users, user)
+		} else {
+			log.Printf("[WARN] User %s is no longer in the account %s", user, accountID)
+		}
+	}
+	if len(configuredUsers) > 0 && len(users) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("users", users)
 
-		if user.AccountID != accountID {
-			users = append(users, user.Email)
+	managedUsers := make([]string, 0)
+	for _, user := range flex.FlattenUsersSet(d.Get("managed_users").(*schema.Set)) {
+		if accountUsers[strings.ToLower(user)] {
+			managedUsers = append(managedUsers, user)
 		}
+	}
+	d.Set("managed_users", managedUsers)
+	d.Set("pending_users", pendingUsers(users, userStates))
+
+	invitedUsers := make([]map[string]interface{}, 0, len(res))
+
+	for _, user := range res {
 		/****** For each user *******************
 		    1) user_id
 		    2) user_level_policies
@@ -591,11 +624,11 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 			IamID:     core.StringPtr(user.IamID),
 			Type:      core.StringPtr("access"),
 		})
-		policies := policyList.Policies
-
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error retrieving user policies: %s", err)
 		}
+		policies := policyList.Policies
+
 		userPolicies := make([]map[string]interface{}, 0, len(policies))
 		for _, policy := range policies {
 			//populate ploicy Roles
@@ -629,12 +662,12 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		for _, grpData := range retreivedGroups.Groups {
 			policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
 				AccountID:     core.StringPtr(accountID),
-				AccessGroupID: core.StringPtr(user.IamID),
+				AccessGroupID: grpData.ID,
 			})
-			accgrpPolicy := policyList.Policies
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error retrieving access group policy: %s", err)
 			}
+			accgrpPolicy := policyList.Policies
 
 			//Fetch access group policies
 			grpPolicies := make([]map[string]interface{}, 0, len(accgrpPolicy))
@@ -682,87 +715,80 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 	}
 	Client := userManagement.UserInvite()
 
+	accountID, err := getAccountID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	accountUsers, userStates, err := getAccountUsers(meta, accountID)
+	if err != nil {
+		return err
+	}
+	managedUsers := flex.FlattenUsersSet(d.Get("managed_users").(*schema.Set))
+
+	added := make([]string, 0)
 	if d.HasChange("users") {
-		//var removedUsers, addedUsers []string
-		accountID, err := getAccountID(d, meta)
-		if err != nil {
-			return err
-		}
 		ousrs, nusrs := d.GetChange("users")
 		old := ousrs.(*schema.Set)
 		new := nusrs.(*schema.Set)
 
 		removed := flex.ExpandStringList(old.Difference(new).List())
-		added := flex.ExpandStringList(new.Difference(old).List())
 
 		//Update the added users
-		if len(added) > 0 {
-			users := make([]v2.User, 0)
-			for _, user := range added {
-				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-			}
-			if len(users) == 0 {
-				return fmt.Errorf("[ERROR] Users email not provided")
-			}
+		added, err = inviteUsers(d, meta, accountID, usersNotInAccount(flex.ExpandStringList(new.Difference(old).List()), accountUsers))
+		managedUsers = append(managedUsers, added...)
+		if err != nil {
+			d.Set("managed_users", managedUsers)
+			return err
+		}
 
-			var accessPolicies []v2.UserPolicy
-			if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-				if err != nil {
-					log.Println("IAM Acess policy: ", err.Error())
-					return err
-				}
+		//Update the removed users
+		for _, user := range removed {
+			if !containsUser(managedUsers, user) {
+				log.Printf("[INFO] User %s was not invited by the resource, it is left in the account", user)
+				continue
 			}
-
-			var accessGroups = make([]string, 0)
-			if data, ok := d.GetOk("access_groups"); ok {
-				for _, accessGroup := range data.([]interface{}) {
-					accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-				}
+			managedUsers = removeUser(managedUsers, user)
+			IAMID := accountUsers[strings.ToLower(user)]
+			if IAMID == "" {
+				log.Printf("[INFO] User %s is already removed from the account", user)
+				continue
 			}
-
-			infraPermissions := getInfraPermissions(d, meta)
-			orgRoles, err := getCloudFoundryRoles(d, meta)
-			if err != nil {
-				return err
+			Err := Client.RemoveUsers(accountID, IAMID)
+			if Err != nil {
+				log.Println("Failed to remove user: ", user)
+				d.Set("managed_users", append(managedUsers, user))
+				return Err
 			}
+		}
+		d.Set("managed_users", managedUsers)
+	}
 
-			inviteUserPayload := v2.UserInvite{}
-
-			inviteUserPayload.Users = users
-			if len(accessGroups) != 0 {
-				inviteUserPayload.AccessGroup = accessGroups
+	// The pending invitations are resent by removing the users and inviting
+	// them again, the users just invited already got a new invitation.
+	if d.HasChange("resend_trigger") {
+		for _, user := range pendingUsers(managedUsers, userStates) {
+			if containsUser(added, user) {
+				continue
 			}
-			if len(accessPolicies) != 0 {
-				inviteUserPayload.IAMPolicy = accessPolicies
+			if err := Client.RemoveUsers(accountID, accountUsers[strings.ToLower(user)]); err != nil {
+				return fmt.Errorf("[ERROR] Error removing user %s to resend the invitation: %s", user, err)
 			}
-			if len(infraPermissions) != 0 {
-				inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-			}
-			if len(orgRoles) != 0 {
-				inviteUserPayload.OrganizationRoles = orgRoles
-			}
-			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
-			if InviteUserError != nil {
-				return InviteUserError
+			if _, err := inviteUsers(d, meta, accountID, []string{user}); err != nil {
+				d.Set("managed_users", removeUser(managedUsers, user))
+				return fmt.Errorf("[ERROR] Error resending the invitation of user %s: %s", user, err)
 			}
+			added = append(added, user)
 		}
+	}
 
-		//Update the removed users
-		if len(removed) > 0 {
-			for _, user := range removed {
-				IAMID, err := getUserIAMID(d, meta, user)
-				if err != nil {
-					return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
-				}
-				Err := Client.RemoveUsers(accountID, IAMID)
-				if Err != nil {
-					log.Println("Failed to remove user: ", user)
-					return Err
-				}
-			}
+	// The users just invited already got their access groups with the
+	// invitation, the others are added to or removed from the access groups
+	// changed in user_access_groups.
+	if d.HasChange("user_access_groups") {
+		if err := updateUserAccessGroups(d, meta, accountID, added); err != nil {
+			return err
 		}
-
 	}
 	return resourceIBMIAMGetUsers(d, meta)
 }
@@ -779,13 +805,20 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	usersSet := d.Get("users").(*schema.Set)
+	accountUsers, _, err := getAccountUsers(meta, accountID)
+	if err != nil {
+		return err
+	}
+
+	// Only the users invited by the resource are removed, the users who were
+	// already in the account are left in it.
+	usersSet := d.Get("managed_users").(*schema.Set)
 	usersList := flex.FlattenUsersSet(usersSet)
 	for _, user := range usersList {
-		IAMID, err := getUserIAMID(d, meta, user)
-
-		if err != nil {
-			return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
+		IAMID := accountUsers[strings.ToLower(user)]
+		if IAMID == "" {
+			log.Printf("[INFO] User %s is already removed from the account", user)
+			continue
 		}
 		Err := Client.RemoveUsers(accountID, IAMID)
 		if Err != nil {
@@ -795,74 +828,235 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMIAMGetUserProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+// getAccountID returns accountID
+func getAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	return userDetails.UserAccount, nil
+}
+
+// getAccountUsers returns the IAM ID and the state of the users of the
+// account, keyed by their lower-cased email.
+func getAccountUsers(meta interface{}, accountID string) (map[string]string, map[string]string, error) {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
-	Client := userManagement.UserInvite()
 
-	accountID, err := getAccountID(d, meta)
+	res, err := userManagement.UserInvite().ListUsers(accountID)
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
 
-	usersSet := d.Get("users").(*schema.Set)
-	usersList := flex.FlattenUsersSet(usersSet)
+	iamIDs := make(map[string]string, len(res))
+	states := make(map[string]string, len(res))
+	for _, userInfo := range res {
+		iamIDs[strings.ToLower(userInfo.Email)] = userInfo.IamID
+		states[strings.ToLower(userInfo.Email)] = userInfo.State
+	}
+	return iamIDs, states, nil
+}
 
-	res, err := Client.ListUsers(accountID)
-	if err != nil {
-		return false, err
+// usersNotInAccount returns the users which are not in the account.
+func usersNotInAccount(users []string, accountUsers map[string]string) []string {
+	newUsers := make([]string, 0, len(users))
+	for _, user := range users {
+		if _, ok := accountUsers[strings.ToLower(user)]; !ok {
+			newUsers = append(newUsers, user)
+		}
 	}
-	var isFound bool
-	for _, user := range usersList {
+	return newUsers
+}
 
-		for _, userInfo := range res {
-			if strings.EqualFold(userInfo.Email, user) {
-				isFound = true
-			}
+// pendingUsers returns the users whose invitation is pending.
+func pendingUsers(users []string, states map[string]string) []string {
+	pending := make([]string, 0)
+	for _, user := range users {
+		if states[strings.ToLower(user)] == "PENDING" {
+			pending = append(pending, user)
 		}
-		if !isFound {
-			return false, nil
+	}
+	return pending
+}
+
+// containsUser reports whether the user is in users, ignoring the case of the
+// emails.
+func containsUser(users []string, user string) bool {
+	for _, u := range users {
+		if strings.EqualFold(u, user) {
+			return true
 		}
 	}
-	return true, nil
+	return false
 }
 
-// getAccountID returns accountID
-func getAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
-	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
-	if err != nil {
-		return "", err
+// removeUser returns users without the user, ignoring the case of the emails.
+func removeUser(users []string, user string) []string {
+	remaining := make([]string, 0, len(users))
+	for _, u := range users {
+		if !strings.EqualFold(u, user) {
+			remaining = append(remaining, u)
+		}
 	}
-	return userDetails.UserAccount, nil
+	return remaining
 }
 
-// getUserIAMID ...
-func getUserIAMID(d *schema.ResourceData, meta interface{}, user string) (string, error) {
+// expandUserAccessGroups returns the access groups of user_access_groups,
+// keyed by the lower-cased email of the user.
+func expandUserAccessGroups(userAccessGroups *schema.Set) map[string][]string {
+	groups := make(map[string][]string, userAccessGroups.Len())
+	for _, v := range userAccessGroups.List() {
+		item := v.(map[string]interface{})
+		user := strings.ToLower(item["user"].(string))
+		groups[user] = append(groups[user], flex.ExpandStringList(item["access_groups"].(*schema.Set).List())...)
+	}
+	return groups
+}
+
+// inviteUsers invites the users with the access groups, policies and roles of
+// the resource, and returns the users invited before any error. The users with
+// access groups of their own are invited one by one, the others all at once.
+func inviteUsers(d *schema.ResourceData, meta interface{}, accountID string, usersList []string) ([]string, error) {
+	invited := make([]string, 0, len(usersList))
+	if len(usersList) == 0 {
+		return invited, nil
+	}
+
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
-		return "", err
+		return invited, err
 	}
-	Client := userManagement.UserInvite()
+	client := userManagement.UserInvite()
 
-	accountID, err := getAccountID(d, meta)
-	if err != nil {
-		return "", err
+	var accessGroups = make([]string, 0)
+	if data, ok := d.GetOk("access_groups"); ok {
+		for _, accessGroup := range data.([]interface{}) {
+			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
+		}
 	}
 
-	res, err := Client.ListUsers(accountID)
+	var accessPolicies []v2.UserPolicy
+	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
+		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
+		if err != nil {
+			log.Println("IAM Acess policy: ", err.Error())
+			return invited, err
+		}
+	}
+
+	infraPermissions := getInfraPermissions(d, meta)
+	orgRoles, err := getCloudFoundryRoles(d, meta)
 	if err != nil {
-		return "", err
+		return invited, err
 	}
 
-	for _, userInfo := range res {
-		if strings.EqualFold(userInfo.Email, user) {
-			return userInfo.IamID, nil
+	invite := func(users []v2.User, accessGroups []string) error {
+		inviteUserPayload := v2.UserInvite{}
+		inviteUserPayload.Users = users
+		if len(accessGroups) != 0 {
+			inviteUserPayload.AccessGroup = accessGroups
+		}
+		if len(accessPolicies) != 0 {
+			inviteUserPayload.IAMPolicy = accessPolicies
+		}
+		if len(infraPermissions) != 0 {
+			inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
+		}
+		if len(orgRoles) != 0 {
+			inviteUserPayload.OrganizationRoles = orgRoles
+		}
+		_, err := client.InviteUsers(accountID, inviteUserPayload)
+		return err
+	}
+
+	userAccessGroups := expandUserAccessGroups(d.Get("user_access_groups").(*schema.Set))
+	users := make([]v2.User, 0, len(usersList))
+	for _, user := range usersList {
+		groups, ok := userAccessGroups[strings.ToLower(user)]
+		if !ok {
+			users = append(users, v2.User{Email: user, AccountRole: MEMBER})
+			continue
 		}
+		userGroups := append(append(make([]string, 0, len(accessGroups)+len(groups)), accessGroups...), groups...)
+		if err := invite([]v2.User{{Email: user, AccountRole: MEMBER}}, userGroups); err != nil {
+			return invited, fmt.Errorf("[ERROR] Error inviting user %s: %s", user, err)
+		}
+		invited = append(invited, user)
 	}
-	return "", nil
+	if len(users) > 0 {
+		if err := invite(users, accessGroups); err != nil {
+			return invited, err
+		}
+		for _, user := range users {
+			invited = append(invited, user.Email)
+		}
+	}
+	return invited, nil
+}
 
+// updateUserAccessGroups adds the users to, and removes them from, the access
+// groups changed in user_access_groups. The users of skipUsers are ignored.
+func updateUserAccessGroups(d *schema.ResourceData, meta interface{}, accountID string, skipUsers []string) error {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+
+	accountUsers, _, err := getAccountUsers(meta, accountID)
+	if err != nil {
+		return err
+	}
+
+	skip := make(map[string]bool, len(skipUsers))
+	for _, user := range skipUsers {
+		skip[strings.ToLower(user)] = true
+	}
+
+	o, n := d.GetChange("user_access_groups")
+	oldGroups := expandUserAccessGroups(o.(*schema.Set))
+	newGroups := expandUserAccessGroups(n.(*schema.Set))
+	users := make(map[string]bool, len(oldGroups)+len(newGroups))
+	for user := range oldGroups {
+		users[user] = true
+	}
+	for user := range newGroups {
+		users[user] = true
+	}
+
+	for user := range users {
+		iamID := accountUsers[user]
+		if skip[user] || iamID == "" {
+			continue
+		}
+		for _, grpID := range newGroups[user] {
+			if flex.StringContains(oldGroups[user], grpID) {
+				continue
+			}
+			member, err := iamAccessGroupsClient.NewAddGroupMembersRequestMembersItem(iamID, "user")
+			if err != nil {
+				return err
+			}
+			addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+			addMembersToAccessGroupOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{*member})
+			_, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error adding user %s to group(%s): %s. API response: %s", user, grpID, err, detailResponse)
+			}
+		}
+		for _, grpID := range oldGroups[user] {
+			if flex.StringContains(newGroups[user], grpID) {
+				continue
+			}
+			removeMemberFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, iamID)
+			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions)
+			if err != nil && (detailResponse == nil || detailResponse.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error removing user %s from group(%s): %s. API response: %s", user, grpID, err, detailResponse)
+			}
+		}
+	}
+	return nil
 }
 
 func getInfraPermissions(d *schema.ResourceData, meta interface{}) []string {
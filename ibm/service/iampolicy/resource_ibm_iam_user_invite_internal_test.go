@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUsersNotInAccount(t *testing.T) {
+	accountUsers := map[string]string{
+		"member@in.ibm.com": "IBMid-1000000001",
+	}
+	tests := []struct {
+		name  string
+		users []string
+		want  []string
+	}{
+		{
+			name:  "No users",
+			users: []string{},
+			want:  []string{},
+		},
+		{
+			name:  "New user",
+			users: []string{"new@in.ibm.com"},
+			want:  []string{"new@in.ibm.com"},
+		},
+		{
+			name:  "Account member ignoring case",
+			users: []string{"Member@in.ibm.com", "new@in.ibm.com"},
+			want:  []string{"new@in.ibm.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := usersNotInAccount(tt.users, accountUsers); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("usersNotInAccount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPendingUsers(t *testing.T) {
+	states := map[string]string{
+		"active@in.ibm.com":  "ACTIVE",
+		"pending@in.ibm.com": "PENDING",
+	}
+	tests := []struct {
+		name  string
+		users []string
+		want  []string
+	}{
+		{
+			name:  "Active user",
+			users: []string{"active@in.ibm.com"},
+			want:  []string{},
+		},
+		{
+			name:  "Pending user ignoring case",
+			users: []string{"active@in.ibm.com", "Pending@in.ibm.com"},
+			want:  []string{"Pending@in.ibm.com"},
+		},
+		{
+			name:  "User not in the account",
+			users: []string{"removed@in.ibm.com"},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pendingUsers(tt.users, states); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pendingUsers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagedUsers(t *testing.T) {
+	managed := []string{"invited@in.ibm.com", "other@in.ibm.com"}
+
+	if !containsUser(managed, "Invited@in.ibm.com") {
+		t.Errorf("containsUser() = false, want true for an invited user")
+	}
+	if containsUser(managed, "member@in.ibm.com") {
+		t.Errorf("containsUser() = true, want false for a user not invited by the resource")
+	}
+
+	want := []string{"other@in.ibm.com"}
+	if got := removeUser(managed, "INVITED@in.ibm.com"); !reflect.DeepEqual(got, want) {
+		t.Errorf("removeUser() = %v, want %v", got, want)
+	}
+	if got := removeUser(managed, "member@in.ibm.com"); !reflect.DeepEqual(got, managed) {
+		t.Errorf("removeUser() = %v, want %v", got, managed)
+	}
+}
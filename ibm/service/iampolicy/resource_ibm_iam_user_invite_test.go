@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMUserInvite_ExistingMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			// The account member was not invited by the resource, so it is
+			// left in the account
+			return testAccCheckIBMIAMUserInviteMember(acc.IAMUser, true)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserInviteConfig(acc.IAMUser, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "managed_users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMUserInvite_Resend(t *testing.T) {
+	user := fmt.Sprintf("terraform-invite-%d@example.com", acctest.RandIntRange(10, 10000))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckIBMIAMUserInviteMember(user, false)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserInviteConfig(user, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "managed_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.0", user),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMUserInviteConfig(user, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "resend_trigger", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "managed_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite_user", "pending_users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMUserInviteMember(user string, member bool) error {
+	userManagement, err := acc.TestAccProvider.Meta().(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return err
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	res, err := userManagement.UserInvite().ListUsers(userDetails.UserAccount)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, userInfo := range res {
+		if strings.EqualFold(userInfo.Email, user) {
+			found = true
+		}
+	}
+	if found != member {
+		return fmt.Errorf("[ERROR] User %s is a member of the account: %t, expected: %t", user, found, member)
+	}
+	return nil
+}
+
+func testAccCheckIBMIAMUserInviteConfig(user, resendTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_user_invite" "invite_user" {
+		users          = ["%s"]
+		resend_trigger = "%s"
+	}
+	`, user, resendTrigger)
+}
@@ -34,6 +34,7 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: policyRuleCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 
 			"ibm_id": {
@@ -486,12 +487,8 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("resource_tags", flex.FlattenV2PolicyResourceTags(*userPolicy.Resource))
 	}
 
-	if _, ok := d.GetOk("rule_conditions"); ok {
-		d.Set("rule_conditions", flex.FlattenRuleConditions(*userPolicy.Rule.(*iampolicymanagementv1.V2PolicyRule)))
-	}
-
-	if _, ok := d.GetOk("rule_operator"); ok {
-		d.Set("rule_operator", *userPolicy.Rule.(*iampolicymanagementv1.V2PolicyRule).Operator)
+	if err = flex.SetV2PolicyRule(d, *userPolicy); err != nil {
+		return err
 	}
 
 	if (&iampolicymanagementv1.V2PolicyResource{}) != userPolicy.Resource {
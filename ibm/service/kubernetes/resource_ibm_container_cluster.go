@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -76,8 +75,9 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			flex.AccessTagsCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -402,6 +402,8 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Description: "Tags for the resource",
 			},
 
+			flex.AccessTags: flex.AccessTagsSchema(),
+
 			"worker_pools": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -899,6 +901,9 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	if err = flex.ReadAccessTags(d, meta, cls.CRN); err != nil {
+		return err
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1155,8 +1160,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || len(flex.GetEnvTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
@@ -1169,6 +1173,15 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 	}
+	if d.HasChange(flex.AccessTags) {
+		cluster, err := clusterAPI.Find(clusterID, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] access tags Error retrieving cluster %s: %s", clusterID, err)
+		}
+		if err = flex.UpdateAccessTags(d, meta, cluster.CRN); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("image_security_enforcement") && !d.IsNewResource() {
 		var imageSecurity bool
@@ -51,8 +51,9 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
+			flex.AccessTagsCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -283,6 +284,8 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "List of tags for the resources",
 			},
 
+			flex.AccessTags: flex.AccessTagsSchema(),
+
 			"wait_till": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -685,8 +688,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	clusterID := d.Id()
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if d.HasChange("tags") || len(flex.GetEnvTags(meta)) > 0 {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -698,6 +700,15 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				"An error occured during update of instance (%s) tags: %s", clusterID, err)
 		}
 	}
+	if d.HasChange(flex.AccessTags) {
+		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", clusterID, err)
+		}
+		if err = flex.UpdateAccessTags(d, meta, cluster.CRN); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("kms_config") {
 		kmsConfig := v2.KmsEnableReq{}
@@ -958,6 +969,9 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+	if err = flex.ReadAccessTags(d, meta, cls.CRN); err != nil {
+		return err
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -34,7 +34,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
-			Update: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -442,12 +442,12 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	for _, s := range *pvmList {
 		if dt, ok := d.GetOk(PIInstanceDeploymentType); ok && dt.(string) == "VMNoStorage" {
-			_, err = isWaitForPIInstanceShutoff(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
+			_, err = isWaitForPIInstanceShutoff(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
+			_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -632,7 +632,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.Errorf("failed to update the lpar: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -643,7 +643,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if d.Get("status") == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -662,13 +662,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
+		err := startLparAfterResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -683,7 +683,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -709,7 +709,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		log.Printf("the instance state is %s", instanceState)
 
 		if (mem > maxMemLpar || procs > maxCPULpar) && instanceState != "SHUTOFF" {
-			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
+			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -731,12 +731,12 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 				return diag.Errorf("failed to update the lpar with the change %v", err)
 			}
 			if instanceState == "SHUTOFF" {
-				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
+				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
 			} else {
-				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK", d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -755,7 +755,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			diag.FromErr(err)
 		}
@@ -766,7 +766,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if d.Get("status") == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -783,13 +783,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Wait for the resize to complete and status to reset
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
+		err := startLparAfterResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -826,7 +826,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 					return diag.FromErr(err)
 				}
 			} else {
-				_, err = isWaitForPIInstancePlacementGroupDelete(ctx, pgClient, *pgID.ID, instanceID)
+				_, err = isWaitForPIInstancePlacementGroupDelete(ctx, pgClient, *pgID.ID, instanceID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -843,7 +843,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			if err != nil {
 				return diag.FromErr(err)
 			} else {
-				_, err = isWaitForPIInstancePlacementGroupAdd(ctx, pgClient, *pgID.ID, instanceID)
+				_, err = isWaitForPIInstancePlacementGroupAdd(ctx, pgClient, *pgID.ID, instanceID, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -854,7 +854,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if d.Get("status") == "ACTIVE" {
 			log.Printf("the lpar is in the Active state, continuing with update")
 		} else {
-			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK", d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -875,7 +875,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, err = isWaitForPIInstanceSoftwareLicenses(ctx, client, instanceID, sl)
+		_, err = isWaitForPIInstanceSoftwareLicenses(ctx, client, instanceID, sl, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -904,7 +904,7 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	for _, instanceID := range idArr[1:] {
-		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -914,7 +914,7 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
+func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
@@ -924,7 +924,7 @@ func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceCli
 		Refresh:    isPIInstanceDeleteRefreshFunc(client, id),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -941,7 +941,7 @@ func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string) re
 	}
 }
 
-func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceClient, id string, instanceReadyStatus string) (interface{}, error) {
+func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceClient, id string, instanceReadyStatus string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be available and active ", id)
 
 	queryTimeOut := activeTimeOut
@@ -955,7 +955,7 @@ func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceC
 		Refresh:    isPIInstanceRefreshFunc(client, id, instanceReadyStatus),
 		Delay:      30 * time.Second,
 		MinTimeout: queryTimeOut,
-		Timeout:    120 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -985,7 +985,7 @@ func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadySt
 	}
 }
 
-func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *st.IBMPIPlacementGroupClient, pgID string, id string) (interface{}, error) {
+func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *st.IBMPIPlacementGroupClient, pgID string, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance Placement Group (%s) to be updated ", id)
 
 	queryTimeOut := activeTimeOut
@@ -996,7 +996,7 @@ func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *st.IBMPIP
 		Refresh:    isPIInstancePlacementGroupAddRefreshFunc(client, pgID, id),
 		Delay:      30 * time.Second,
 		MinTimeout: queryTimeOut,
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1017,7 +1017,7 @@ func isPIInstancePlacementGroupAddRefreshFunc(client *st.IBMPIPlacementGroupClie
 	}
 }
 
-func isWaitForPIInstancePlacementGroupDelete(ctx context.Context, client *st.IBMPIPlacementGroupClient, pgID string, id string) (interface{}, error) {
+func isWaitForPIInstancePlacementGroupDelete(ctx context.Context, client *st.IBMPIPlacementGroupClient, pgID string, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance Placement Group (%s) to be updated ", id)
 
 	queryTimeOut := activeTimeOut
@@ -1028,7 +1028,7 @@ func isWaitForPIInstancePlacementGroupDelete(ctx context.Context, client *st.IBM
 		Refresh:    isPIInstancePlacementGroupDeleteRefreshFunc(client, pgID, id),
 		Delay:      30 * time.Second,
 		MinTimeout: queryTimeOut,
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1049,7 +1049,7 @@ func isPIInstancePlacementGroupDeleteRefreshFunc(client *st.IBMPIPlacementGroupC
 	}
 }
 
-func isWaitForPIInstanceSoftwareLicenses(ctx context.Context, client *st.IBMPIInstanceClient, id string, softwareLicenses *models.SoftwareLicenses) (interface{}, error) {
+func isWaitForPIInstanceSoftwareLicenses(ctx context.Context, client *st.IBMPIInstanceClient, id string, softwareLicenses *models.SoftwareLicenses, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance Software Licenses (%s) to be updated ", id)
 
 	queryTimeOut := activeTimeOut
@@ -1060,7 +1060,7 @@ func isWaitForPIInstanceSoftwareLicenses(ctx context.Context, client *st.IBMPIIn
 		Refresh:    isPIInstanceSoftwareLicensesRefreshFunc(client, id, softwareLicenses),
 		Delay:      90 * time.Second,
 		MinTimeout: queryTimeOut,
-		Timeout:    120 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1102,7 +1102,7 @@ func isPIInstanceSoftwareLicensesRefreshFunc(client *st.IBMPIInstanceClient, id
 	}
 }
 
-func isWaitForPIInstanceShutoff(ctx context.Context, client *st.IBMPIInstanceClient, id string, instanceReadyStatus string) (interface{}, error) {
+func isWaitForPIInstanceShutoff(ctx context.Context, client *st.IBMPIInstanceClient, id string, instanceReadyStatus string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be shutoff and health active ", id)
 
 	queryTimeOut := activeTimeOut
@@ -1116,7 +1116,7 @@ func isWaitForPIInstanceShutoff(ctx context.Context, client *st.IBMPIInstanceCli
 		Refresh:    isPIInstanceShutoffRefreshFunc(client, id, instanceReadyStatus),
 		Delay:      30 * time.Second,
 		MinTimeout: queryTimeOut,
-		Timeout:    120 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1154,7 +1154,7 @@ func encodeBase64(userData string) string {
 	return userData
 }
 
-func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
+func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
 	stateConf := &retry.StateChangeConf{
@@ -1163,7 +1163,7 @@ func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceCli
 		Refresh:    isPIInstanceRefreshFuncOff(client, id),
 		Delay:      10 * time.Second,
 		MinTimeout: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
-		Timeout:    30 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1184,7 +1184,7 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) retry
 	}
 }
 
-func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string) error {
+func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		//Action: flex.PtrToString("stop"),
 		Action: flex.PtrToString("immediate-shutdown"),
@@ -1194,13 +1194,13 @@ func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClie
 		return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
 	}
 
-	_, err = isWaitForPIInstanceStopped(ctx, client, id)
+	_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout)
 
 	return err
 }
 
 // Start the lpar
-func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string) error {
+func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		Action: flex.PtrToString("start"),
 	}
@@ -1209,13 +1209,13 @@ func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceC
 		return fmt.Errorf("failed to perform the start action on the pvm instance %v", err)
 	}
 
-	_, err = isWaitForPIInstanceAvailable(ctx, client, id, "OK")
+	_, err = isWaitForPIInstanceAvailable(ctx, client, id, "OK", timeout)
 
 	return err
 }
 
 // Stop / Modify / Start only when the lpar is off limits
-func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient, id, cloudInstanceID string, mem, procs float64) error {
+func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient, id, cloudInstanceID string, mem, procs float64, timeout time.Duration) error {
 	/*
 		These are the steps
 		1. Stop the lpar - Check if the lpar is SHUTOFF
@@ -1226,7 +1226,7 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 	//Execute the stop
 
 	log.Printf("Calling the stop lpar for Resource Change code ..")
-	err := stopLparForResourceChange(ctx, client, id)
+	err := stopLparForResourceChange(ctx, client, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -1241,14 +1241,14 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 		return fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
 	}
 
-	_, err = isWaitforPIInstanceUpdate(ctx, client, id)
+	_, err = isWaitforPIInstanceUpdate(ctx, client, id, timeout)
 	if err != nil {
 		return fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
 	}
 
 	// Now we can start the lpar
 	log.Printf("Calling the start lpar After the  Resource Change code ..")
-	err = startLparAfterResourceChange(ctx, client, id)
+	err = startLparAfterResourceChange(ctx, client, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -1257,7 +1257,7 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 
 }
 
-func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
+func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be ACTIVE or SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
 	stateConf := &retry.StateChangeConf{
@@ -1266,7 +1266,7 @@ func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClie
 		Refresh:    isPIInstanceShutAfterResourceChange(client, id),
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Minute,
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
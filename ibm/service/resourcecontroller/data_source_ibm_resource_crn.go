@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceIBMResourceCRN builds the CRN of a resource locally, without
+// calling any API, so IAM policies and CBR rules can reference resources
+// which aren't managed by the configuration.
+func DataSourceIBMResourceCRN() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourceCRNRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Description: "The name of the service, for example is",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"region": {
+				Description: "The region of the resource, empty or global for the global services",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"account": {
+				Description: "The ID of the account owning the resource",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"resource_type": {
+				Description: "The type of the resource, empty for a service instance",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"resource": {
+				Description: "The ID of the resource, empty for a service instance",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"crn": {
+				Description: "The CRN of the resource",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceCRNRead(d *schema.ResourceData, meta interface{}) error {
+	crn, err := flex.BuildCRN(
+		d.Get("service").(string),
+		d.Get("region").(string),
+		d.Get("account").(string),
+		d.Get("resource_type").(string),
+		d.Get("resource").(string),
+	)
+	if err != nil {
+		return err
+	}
+	d.SetId(crn)
+	d.Set("crn", crn)
+	return nil
+}
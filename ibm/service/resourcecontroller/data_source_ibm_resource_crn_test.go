@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceCRNDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceCRNDataSourceConfig("vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_crn.testacc_ds_resource_crn", "crn", "crn:v1:bluemix:public:is:us-south:a/1234::vpc:r006-1234"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceCRNDataSource_invalid_resource_type(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceCRNDataSourceConfig("vpc:subnet"),
+				ExpectError: regexp.MustCompile("The resource type of a CRN can't contain"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceCRNDataSourceConfig(resourceType string) string {
+	return `
+data "ibm_resource_crn" "testacc_ds_resource_crn" {
+  service       = "is"
+  region        = "us-south"
+  account       = "1234"
+  resource_type = "` + resourceType + `"
+  resource      = "r006-1234"
+}`
+}
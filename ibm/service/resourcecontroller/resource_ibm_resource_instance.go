@@ -8,12 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,12 +36,14 @@ const (
 
 func ResourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMResourceInstanceCreate,
-		Read:     ResourceIBMResourceInstanceRead,
-		Update:   ResourceIBMResourceInstanceUpdate,
-		Delete:   ResourceIBMResourceInstanceDelete,
-		Exists:   ResourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		Create: ResourceIBMResourceInstanceCreate,
+		Read:   ResourceIBMResourceInstanceRead,
+		Update: ResourceIBMResourceInstanceUpdate,
+		Delete: ResourceIBMResourceInstanceDelete,
+		Exists: ResourceIBMResourceInstanceExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMResourceInstanceImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -51,7 +53,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -516,7 +518,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return flex.SDKQuotaErrorf(err, resp, "CreateResourceInstance failed", "ibm_resource_instance", "create", meta)
 	}
 
 	d.SetId(*instance.ID)
@@ -526,8 +528,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTags(d, "tags", meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -843,6 +844,101 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
+// resourceIBMResourceInstanceImport accepts the CRN of the instance, its GUID,
+// or a name/resource_group/service identifier made of the names of the
+// instance, of its resource group and of its service, e.g.
+// my-instance/default/cloud-object-storage.
+func resourceIBMResourceInstanceImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "crn:") {
+		return []*schema.ResourceData{d}, nil
+	}
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.Contains(d.Id(), "/") {
+		instanceGUID := d.Id()
+		instance, resp, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+			ID: &instanceGUID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving resource instance %s: %s with resp code: %s", instanceGUID, err, resp)
+		}
+		d.SetId(*instance.ID)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a CRN, a GUID or a combination of name/resource_group/service", d.Id())
+	}
+	name, resourceGroupName, service := parts[0], parts[1], parts[2]
+
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	resourceGroupList := rg.ListResourceGroupsOptions{
+		AccountID: &userDetails.UserAccount,
+		Name:      &resourceGroupName,
+	}
+	groups, resp, err := rMgtClient.ListResourceGroupsWithContext(context, &resourceGroupList)
+	if err != nil || groups == nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving resource group %s: %s %s", resourceGroupName, err, resp)
+	}
+	if len(groups.Resources) == 0 {
+		return nil, fmt.Errorf("[ERROR] Resource group %s is not found in the account", resourceGroupName)
+	}
+
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	serviceOff, err := rsCatClient.ResourceCatalog().FindByName(service, true)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving service offering %s: %s", service, err)
+	}
+
+	resourceInstanceListOptions := rc.ListResourceInstancesOptions{
+		Name:            &name,
+		ResourceGroupID: groups.Resources[0].ID,
+		ResourceID:      &serviceOff[0].ID,
+	}
+	var instances []rc.ResourceInstance
+	next_url := ""
+	for {
+		if next_url != "" {
+			resourceInstanceListOptions.Start = &next_url
+		}
+		listInstanceResponse, resp, err := rsConClient.ListResourceInstancesWithContext(context, &resourceInstanceListOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		}
+		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
+		if err != nil {
+			return nil, fmt.Errorf("[DEBUG] ListResourceInstances failed. Error occurred while parsing NextURL: %s", err)
+		}
+		instances = append(instances, listInstanceResponse.Resources...)
+		if next_url == "" {
+			break
+		}
+	}
+
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("[ERROR] No resource instance found with name %s of service %s in resource group %s", name, service, resourceGroupName)
+	}
+	if len(instances) > 1 {
+		return nil, fmt.Errorf("[ERROR] More than one resource instance found with name %s of service %s in resource group %s, import it with its CRN instead", name, service, resourceGroupName)
+	}
+	d.SetId(*instances[0].ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
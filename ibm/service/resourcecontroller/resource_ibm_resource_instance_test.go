@@ -99,6 +99,40 @@ func TestAccIBMResourceInstanceImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceImportByName(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-ins-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceBasic(serviceName) + `
+	data "ibm_resource_group" "default" {
+		is_default = true
+	}
+	`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					group := s.RootModule().Resources["data.ibm_resource_group.default"]
+					return fmt.Sprintf("%s/%s/cloud-object-storage", serviceName, group.Primary.Attributes["name"]), nil
+				},
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_time_minutes", "parameters"},
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceInstanceWithServiceendpoints(t *testing.T) {
 	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
 	resourceName := "ibm_resource_instance.instance"
@@ -0,0 +1,519 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	tgPeeringVpcs = "vpcs"
+	tgPeeringCrn  = "crn"
+)
+
+// ResourceIBMTransitGatewayVpcPeering connects two VPCs through a dedicated
+// transit gateway, managing the gateway, its two VPC connections and their
+// prefix filters as a single resource.
+func ResourceIBMTransitGatewayVpcPeering() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMTransitGatewayVpcPeeringCreate,
+		Read:     resourceIBMTransitGatewayVpcPeeringRead,
+		Update:   resourceIBMTransitGatewayVpcPeeringUpdate,
+		Delete:   resourceIBMTransitGatewayVpcPeeringDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_gateway", tgName),
+				Description:  "The name of the transit gateway connecting the VPCs",
+			},
+			tgLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Location of the transit gateway",
+			},
+			tgGlobal: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Propagate the routes of the VPCs across regions. Required when the VPCs are in different regions",
+			},
+			tgResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group of the transit gateway",
+			},
+			tgPeeringVpcs: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				MaxItems:    2,
+				Description: "The two VPCs connected to each other",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgPeeringCrn: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the VPC",
+						},
+						tgName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgName),
+							Description:  "The name of the connection of the VPC. If unspecified, the name of the VPC is used",
+						},
+						tgDefaultPrefixFilter: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_tg_connection_prefix_filter", tgAction),
+							Description:  "Whether to permit or deny the prefixes not matching any of the prefix filters",
+						},
+						tgPrefixFilters: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The prefix filters of the connection of the VPC, in the order they are applied",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									tgAction: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_tg_connection_prefix_filter", tgAction),
+										Description:  "Whether to permit or deny the prefix filter",
+									},
+									tgPrefix: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "IP Prefix",
+									},
+									tgGe: {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "IP Prefix GE",
+									},
+									tgLe: {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "IP Prefix LE",
+									},
+								},
+							},
+						},
+						tgConnectionId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Transit Gateway Connection identifier",
+						},
+						tgStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the connection of the VPC",
+						},
+					},
+				},
+			},
+			tgCrn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the transit gateway",
+			},
+			tgStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the transit gateway",
+			},
+		},
+	}
+}
+
+func resourceIBMTransitGatewayVpcPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(tgName).(string)
+	location := d.Get(tgLocation).(string)
+	global := d.Get(tgGlobal).(bool)
+	createTransitGatewayOptions := &transitgatewayapisv1.CreateTransitGatewayOptions{
+		Name:     &name,
+		Location: &location,
+		Global:   &global,
+	}
+	if rsg, ok := d.GetOk(tgResourceGroup); ok {
+		resourceGroup := rsg.(string)
+		createTransitGatewayOptions.ResourceGroup = &transitgatewayapisv1.ResourceGroupIdentity{ID: &resourceGroup}
+	}
+
+	tgw, response, err := client.CreateTransitGateway(createTransitGatewayOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Create Transit Gateway err %s\n%s", err, response)
+	}
+	d.SetId(*tgw.ID)
+
+	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	vpcs := d.Get(tgPeeringVpcs).([]interface{})
+	for _, vpcIntf := range vpcs {
+		vpc := vpcIntf.(map[string]interface{})
+		vpc[tgConnectionId], err = tgVpcPeeringCreateConnection(client, d.Id(), vpc, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			// Keep the connections created so far, so that they are deleted with the gateway.
+			d.Set(tgPeeringVpcs, vpcs)
+			return err
+		}
+	}
+	d.Set(tgPeeringVpcs, vpcs)
+
+	return resourceIBMTransitGatewayVpcPeeringRead(d, meta)
+}
+
+// tgVpcPeeringCreateConnection attaches the VPC to the gateway, waits for the
+// connection to be attached and applies its prefix filters in order.
+func tgVpcPeeringCreateConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId string, vpc map[string]interface{}, timeout time.Duration) (string, error) {
+	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
+	createTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
+	createTransitGatewayConnectionOptions.SetNetworkType("vpc")
+	createTransitGatewayConnectionOptions.SetNetworkID(vpc[tgPeeringCrn].(string))
+	if name, ok := vpc[tgName].(string); ok && name != "" {
+		createTransitGatewayConnectionOptions.SetName(name)
+	}
+	if prefixFilterDefault, ok := vpc[tgDefaultPrefixFilter].(string); ok && prefixFilterDefault != "" {
+		createTransitGatewayConnectionOptions.SetPrefixFiltersDefault(prefixFilterDefault)
+	}
+
+	tgConnection, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
+	}
+	connectionId := *tgConnection.ID
+
+	_, err = isWaitForTransitGatewayConnectionAvailable(client, fmt.Sprintf("%s/%s", gatewayId, connectionId), timeout)
+	if err != nil {
+		return connectionId, err
+	}
+
+	err = tgVpcPeeringCreatePrefixFilters(client, gatewayId, connectionId, vpc[tgPrefixFilters].([]interface{}))
+	return connectionId, err
+}
+
+// tgVpcPeeringCreatePrefixFilters appends the prefix filters to the
+// connection. A filter created without before is placed last, so creating
+// them one by one keeps the configured order.
+func tgVpcPeeringCreatePrefixFilters(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId string, prefixFilters []interface{}) error {
+	for _, prefixFilterIntf := range prefixFilters {
+		prefixFilter := prefixFilterIntf.(map[string]interface{})
+		createPrefixFilterOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions{}
+		createPrefixFilterOptions.SetTransitGatewayID(gatewayId)
+		createPrefixFilterOptions.SetID(connectionId)
+		createPrefixFilterOptions.SetAction(prefixFilter[tgAction].(string))
+		createPrefixFilterOptions.SetPrefix(prefixFilter[tgPrefix].(string))
+		if ge := prefixFilter[tgGe].(int); ge > 0 {
+			createPrefixFilterOptions.SetGe(int64(ge))
+		}
+		if le := prefixFilter[tgLe].(int); le > 0 {
+			createPrefixFilterOptions.SetLe(int64(le))
+		}
+		_, response, err := client.CreateTransitGatewayConnectionPrefixFilter(createPrefixFilterOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Create Transit Gateway connection prefix filter err %s\n%s", err, response)
+		}
+	}
+	return nil
+}
+
+// tgVpcPeeringDeletePrefixFilters removes all the prefix filters of the
+// connection.
+func tgVpcPeeringDeletePrefixFilters(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId string) error {
+	listPrefixFiltersOptions := &transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions{}
+	listPrefixFiltersOptions.SetTransitGatewayID(gatewayId)
+	listPrefixFiltersOptions.SetID(connectionId)
+	prefixFilters, response, err := client.ListTransitGatewayConnectionPrefixFilters(listPrefixFiltersOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while listing transit gateway connection prefix filters %s\n%s", err, response)
+	}
+	for _, prefixFilter := range prefixFilters.PrefixFilters {
+		deletePrefixFilterOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions{}
+		deletePrefixFilterOptions.SetTransitGatewayID(gatewayId)
+		deletePrefixFilterOptions.SetID(connectionId)
+		deletePrefixFilterOptions.SetFilterID(*prefixFilter.ID)
+		response, err := client.DeleteTransitGatewayConnectionPrefixFilter(deletePrefixFilterOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection Prefix Filter(%s): %s\n%s", *prefixFilter.ID, err, response)
+		}
+	}
+	return nil
+}
+
+func tgVpcPeeringDeleteConnection(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId string, timeout time.Duration) error {
+	deleteTransitGatewayConnectionOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionOptions{}
+	deleteTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
+	deleteTransitGatewayConnectionOptions.SetID(connectionId)
+	response, err := client.DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", connectionId, err, response)
+	}
+	_, err = isWaitForTransitGatewayConnectionDeleted(client, fmt.Sprintf("%s/%s", gatewayId, connectionId), timeout)
+	return err
+}
+
+func resourceIBMTransitGatewayVpcPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	tgw, response, err := client.GetTransitGateway(&transitgatewayapisv1.GetTransitGatewayOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting Transit Gateway (%s): %s\n%s", id, err, response)
+	}
+
+	d.Set(tgName, tgw.Name)
+	d.Set(tgLocation, tgw.Location)
+	d.Set(tgGlobal, tgw.Global)
+	d.Set(tgCrn, tgw.Crn)
+	d.Set(tgStatus, tgw.Status)
+	if tgw.ResourceGroup != nil {
+		d.Set(tgResourceGroup, *tgw.ResourceGroup.ID)
+	}
+
+	vpcList := d.Get(tgPeeringVpcs).([]interface{})
+	if len(vpcList) == 0 {
+		// On import, the VPCs are the vpc connections of the gateway.
+		vpcList, err = tgVpcPeeringListConnections(client, id)
+		if err != nil {
+			return err
+		}
+	}
+	vpcs := make([]map[string]interface{}, 0, 2)
+	for _, vpcIntf := range vpcList {
+		vpc := vpcIntf.(map[string]interface{})
+		connectionId := vpc[tgConnectionId].(string)
+		if connectionId == "" {
+			continue
+		}
+		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+		getTransitGatewayConnectionOptions.SetTransitGatewayID(id)
+		getTransitGatewayConnectionOptions.SetID(connectionId)
+		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				// Clearing the crn shows a diff, and the connection is recreated on the next apply.
+				vpc[tgPeeringCrn] = ""
+				vpc[tgConnectionId] = ""
+				vpc[tgStatus] = ""
+				vpcs = append(vpcs, vpc)
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", connectionId, err, response)
+		}
+		if tgConnection.NetworkID != nil {
+			vpc[tgPeeringCrn] = *tgConnection.NetworkID
+		}
+		vpc[tgName] = *tgConnection.Name
+		vpc[tgStatus] = *tgConnection.Status
+		if tgConnection.PrefixFiltersDefault != nil {
+			vpc[tgDefaultPrefixFilter] = *tgConnection.PrefixFiltersDefault
+		}
+
+		listPrefixFiltersOptions := &transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions{}
+		listPrefixFiltersOptions.SetTransitGatewayID(id)
+		listPrefixFiltersOptions.SetID(connectionId)
+		prefixFilters, response, err := client.ListTransitGatewayConnectionPrefixFilters(listPrefixFiltersOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while listing transit gateway connection prefix filters %s\n%s", err, response)
+		}
+		prefixFilterList := make([]map[string]interface{}, 0, len(prefixFilters.PrefixFilters))
+		for _, prefixFilter := range prefixFilters.PrefixFilters {
+			prefixFilterMap := map[string]interface{}{
+				tgAction: *prefixFilter.Action,
+				tgPrefix: *prefixFilter.Prefix,
+			}
+			if prefixFilter.Ge != nil {
+				prefixFilterMap[tgGe] = int(*prefixFilter.Ge)
+			}
+			if prefixFilter.Le != nil {
+				prefixFilterMap[tgLe] = int(*prefixFilter.Le)
+			}
+			prefixFilterList = append(prefixFilterList, prefixFilterMap)
+		}
+		vpc[tgPrefixFilters] = prefixFilterList
+		vpcs = append(vpcs, vpc)
+	}
+	if err = d.Set(tgPeeringVpcs, vpcs); err != nil {
+		return fmt.Errorf("[ERROR] Error setting vpcs %s", err)
+	}
+	return nil
+}
+
+func tgVpcPeeringListConnections(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId string) ([]interface{}, error) {
+	startSub := ""
+	listTransitGatewayConnectionsOptions := &transitgatewayapisv1.ListTransitGatewayConnectionsOptions{}
+	listTransitGatewayConnectionsOptions.SetTransitGatewayID(gatewayId)
+	vpcs := make([]interface{}, 0, 2)
+	for {
+		if startSub != "" {
+			listTransitGatewayConnectionsOptions.Start = &startSub
+		}
+		listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error while listing transit gateway connections %s\n%s", err, response)
+		}
+		for _, connection := range listTGConnections.Connections {
+			if connection.NetworkType != nil && *connection.NetworkType == "vpc" {
+				vpcs = append(vpcs, map[string]interface{}{tgConnectionId: *connection.ID})
+			}
+		}
+		startSub = flex.GetNext(listTGConnections.Next)
+		if startSub == "" {
+			break
+		}
+	}
+	return vpcs, nil
+}
+
+func resourceIBMTransitGatewayVpcPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	if d.HasChange(tgName) || d.HasChange(tgGlobal) {
+		updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{}
+		updateTransitGatewayOptions.ID = &id
+		if d.HasChange(tgName) {
+			name := d.Get(tgName).(string)
+			updateTransitGatewayOptions.Name = &name
+		}
+		if d.HasChange(tgGlobal) {
+			global := d.Get(tgGlobal).(bool)
+			updateTransitGatewayOptions.Global = &global
+		}
+		_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error in Update Transit Gateway : %s\n%s", err, response)
+		}
+	}
+
+	if d.HasChange(tgPeeringVpcs) {
+		vpcs := d.Get(tgPeeringVpcs).([]interface{})
+		for i, vpcIntf := range vpcs {
+			vpc := vpcIntf.(map[string]interface{})
+			vpcPath := fmt.Sprintf("%s.%d", tgPeeringVpcs, i)
+			oldConnectionId, _ := d.GetChange(vpcPath + "." + tgConnectionId)
+			connectionId := oldConnectionId.(string)
+
+			// A new VPC, or a connection deleted outside of terraform, needs a new connection.
+			if connectionId == "" || d.HasChange(vpcPath+"."+tgPeeringCrn) {
+				if connectionId != "" {
+					if err = tgVpcPeeringDeleteConnection(client, id, connectionId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return err
+					}
+				}
+				vpc[tgConnectionId], err = tgVpcPeeringCreateConnection(client, id, vpc, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					d.Set(tgPeeringVpcs, vpcs)
+					return err
+				}
+				continue
+			}
+
+			if d.HasChange(vpcPath+"."+tgName) || d.HasChange(vpcPath+"."+tgDefaultPrefixFilter) {
+				updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
+				updateTransitGatewayConnectionOptions.SetTransitGatewayID(id)
+				updateTransitGatewayConnectionOptions.SetID(connectionId)
+				if d.HasChange(vpcPath + "." + tgName) {
+					updateTransitGatewayConnectionOptions.SetName(vpc[tgName].(string))
+				}
+				if d.HasChange(vpcPath + "." + tgDefaultPrefixFilter) {
+					updateTransitGatewayConnectionOptions.SetPrefixFiltersDefault(vpc[tgDefaultPrefixFilter].(string))
+				}
+				_, response, err := client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
+				if err != nil {
+					return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+				}
+			}
+
+			// The filters are ordered, so they are replaced as a whole.
+			if d.HasChange(vpcPath + "." + tgPrefixFilters) {
+				if err = tgVpcPeeringDeletePrefixFilters(client, id, connectionId); err != nil {
+					return err
+				}
+				if err = tgVpcPeeringCreatePrefixFilters(client, id, connectionId, vpc[tgPrefixFilters].([]interface{})); err != nil {
+					return err
+				}
+			}
+		}
+		d.Set(tgPeeringVpcs, vpcs)
+	}
+
+	return resourceIBMTransitGatewayVpcPeeringRead(d, meta)
+}
+
+func resourceIBMTransitGatewayVpcPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	// A gateway cannot be deleted while it still has connections.
+	for _, vpcIntf := range d.Get(tgPeeringVpcs).([]interface{}) {
+		connectionId := vpcIntf.(map[string]interface{})[tgConnectionId].(string)
+		if connectionId == "" {
+			continue
+		}
+		if err = tgVpcPeeringDeleteConnection(client, id, connectionId, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	response, err := client.DeleteTransitGateway(&transitgatewayapisv1.DeleteTransitGatewayOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting Transit Gateway (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForTransitGatewayDeleted(client, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Transit Gateway VPC peering (%s) deleted", id)
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMTransitGatewayVpcPeering_basic(t *testing.T) {
+	randNum := acctest.RandIntRange(10, 100)
+	gatewayName := fmt.Sprintf("tg-peering-%d", randNum)
+	vpcName1 := fmt.Sprintf("tg-peering-vpc1-%d", randNum)
+	vpcName2 := fmt.Sprintf("tg-peering-vpc2-%d", randNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayVpcPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayVpcPeeringConfig(gatewayName, vpcName1, vpcName2, "10.240.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "name", gatewayName),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.#", "2"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.0.status", "attached"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.1.status", "attached"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.0.prefix_filters.0.prefix", "10.240.0.0/16"),
+				),
+			},
+			{
+				Config: testAccCheckIBMTransitGatewayVpcPeeringConfig(gatewayName, vpcName1, vpcName2, "10.241.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.0.prefix_filters.#", "1"),
+					resource.TestCheckResourceAttr("ibm_tg_vpc_peering.test_tg_peering", "vpcs.0.prefix_filters.0.prefix", "10.241.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      "ibm_tg_vpc_peering.test_tg_peering",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayVpcPeeringConfig(gatewayName, vpcName1, vpcName2, prefix string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test_tg_vpc1" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc" "test_tg_vpc2" {
+		name = "%s"
+	}
+
+	resource "ibm_tg_vpc_peering" "test_tg_peering" {
+		name     = "%s"
+		location = "us-south"
+		vpcs {
+			crn                   = ibm_is_vpc.test_tg_vpc1.resource_crn
+			default_prefix_filter = "deny"
+			prefix_filters {
+				action = "permit"
+				prefix = "%s"
+				le     = 24
+			}
+		}
+		vpcs {
+			crn = ibm_is_vpc.test_tg_vpc2.resource_crn
+		}
+	}
+	`, vpcName1, vpcName2, gatewayName, prefix)
+}
+
+func testAccCheckIBMTransitGatewayVpcPeeringDestroy(s *terraform.State) error {
+	client, err := transitgatewayClient(acc.TestAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_tg_vpc_peering" {
+			continue
+		}
+
+		getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err = client.GetTransitGateway(getTransitGatewayOptions)
+		if err == nil {
+			return fmt.Errorf(" transit gateway still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isCIDRSubnetsBase    = "base"
+	isCIDRSubnetsZones   = "zones"
+	isCIDRSubnetsNewbits = "newbits"
+	isCIDRSubnetsCIDRs   = "cidrs"
+)
+
+// DataSourceIBMISCIDRSubnets plans the per-zone subnets of a VPC address
+// prefix locally, without calling the VPC API.
+func DataSourceIBMISCIDRSubnets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMISCIDRSubnetsRead,
+
+		Schema: map[string]*schema.Schema{
+			isCIDRSubnetsBase: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The IPv4 CIDR the subnets are carved out of.",
+			},
+			isCIDRSubnetsZones: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of subnets, one per zone.",
+			},
+			isCIDRSubnetsNewbits: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+				Description:  "The number of bits extending the prefix of the base CIDR.",
+			},
+			isCIDRSubnetsCIDRs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The subnet CIDRs, skipping the IBM Cloud reserved ranges.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISCIDRSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	base := d.Get(isCIDRSubnetsBase).(string)
+	zones := d.Get(isCIDRSubnetsZones).(int)
+	newbits := d.Get(isCIDRSubnetsNewbits).(int)
+
+	cidrs, err := flex.CIDRSubnets(base, zones, newbits)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%d/%d", base, zones, newbits))
+	d.Set(isCIDRSubnetsCIDRs, cidrs)
+	return nil
+}
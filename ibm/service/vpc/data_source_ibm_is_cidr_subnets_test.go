@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISCIDRSubnetsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISCIDRSubnetsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_cidr_subnets.example", "cidrs.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_is_cidr_subnets.example", "cidrs.0", "10.240.0.0/18"),
+					resource.TestCheckResourceAttr("data.ibm_is_cidr_subnets.example", "cidrs.2", "10.240.128.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISCIDRSubnetsDataSourceConfig() string {
+	return `
+	data "ibm_is_cidr_subnets" "example" {
+		base    = "10.240.0.0/16"
+		zones   = 3
+		newbits = 2
+	}
+	`
+}
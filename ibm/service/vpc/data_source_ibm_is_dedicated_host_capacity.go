@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// DataSourceIbmIsDedicatedHostCapacity summarizes the vCPU and memory
+// capacity of the dedicated hosts, so that the host an instance is placed on
+// or migrated to can be chosen from the configuration.
+func DataSourceIbmIsDedicatedHostCapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmIsDedicatedHostCapacityRead,
+
+		Schema: map[string]*schema.Schema{
+			"host_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique identifier of the dedicated host group of the dedicated hosts",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The zone name of the dedicated hosts",
+			},
+			"vcpu_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of vCPUs of the dedicated hosts enabled for instance placement",
+			},
+			"available_vcpu_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vCPUs still available on the dedicated hosts enabled for instance placement",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total memory in GiB of the dedicated hosts enabled for instance placement",
+			},
+			"available_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory in GiB still available on the dedicated hosts enabled for instance placement",
+			},
+			"vcpu_utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of the vCPUs of the dedicated hosts enabled for instance placement which are used",
+			},
+			"memory_utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of the memory of the dedicated hosts enabled for instance placement which is used",
+			},
+			"dedicated_hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The capacity of each dedicated host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this dedicated host",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique user-defined name for this dedicated host",
+						},
+						"instance_placement_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set to true, instances can be placed on this dedicated host",
+						},
+						"instance_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of instances on this dedicated host",
+						},
+						"vcpu_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vCPUs of this dedicated host",
+						},
+						"available_vcpu_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vCPUs still available on this dedicated host",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total memory in GiB of this dedicated host",
+						},
+						"available_memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory in GiB still available on this dedicated host",
+						},
+						"vcpu_utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The percentage of the vCPUs of this dedicated host which are used",
+						},
+						"memory_utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The percentage of the memory of this dedicated host which is used",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmIsDedicatedHostCapacityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listDedicatedHostsOptions := &vpcv1.ListDedicatedHostsOptions{}
+	if hostgroupintf, ok := d.GetOk("host_group"); ok {
+		hostgroupid := hostgroupintf.(string)
+		listDedicatedHostsOptions.DedicatedHostGroupID = &hostgroupid
+	}
+	if zoneintf, ok := d.GetOk("zone"); ok {
+		zoneName := zoneintf.(string)
+		listDedicatedHostsOptions.ZoneName = &zoneName
+	}
+	allrecs, err := flex.GetAllPages(func(start string) ([]vpcv1.DedicatedHost, string, error) {
+		if start != "" {
+			listDedicatedHostsOptions.Start = &start
+		}
+		dedicatedHostCollection, response, err := vpcClient.ListDedicatedHostsWithContext(context, listDedicatedHostsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s\n%s", err, response)
+			return nil, "", fmt.Errorf("ListDedicatedHostsWithContext failed %s\n%s", err, response)
+		}
+		return dedicatedHostCollection.DedicatedHosts, flex.GetNext(dedicatedHostCollection.Next), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var vcpuCount, availableVcpuCount, memory, availableMemory int64
+	dedicatedHosts := make([]map[string]interface{}, 0, len(allrecs))
+	for _, host := range allrecs {
+		hostCapacity := dedicatedHostCapacity(host)
+		dedicatedHosts = append(dedicatedHosts, hostCapacity.toMap())
+		if host.InstancePlacementEnabled != nil && *host.InstancePlacementEnabled {
+			vcpuCount += hostCapacity.vcpuCount
+			availableVcpuCount += hostCapacity.availableVcpuCount
+			memory += hostCapacity.memory
+			availableMemory += hostCapacity.availableMemory
+		}
+	}
+
+	d.SetId(dataSourceIbmIsDedicatedHostsID(d))
+	d.Set("vcpu_count", vcpuCount)
+	d.Set("available_vcpu_count", availableVcpuCount)
+	d.Set("memory", memory)
+	d.Set("available_memory", availableMemory)
+	d.Set("vcpu_utilization", utilizationPercentage(vcpuCount, availableVcpuCount))
+	d.Set("memory_utilization", utilizationPercentage(memory, availableMemory))
+	if err = d.Set("dedicated_hosts", dedicatedHosts); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting dedicated_hosts %s", err))
+	}
+	return nil
+}
+
+type dedicatedHostCapacityInfo struct {
+	host               vpcv1.DedicatedHost
+	vcpuCount          int64
+	availableVcpuCount int64
+	memory             int64
+	availableMemory    int64
+}
+
+func dedicatedHostCapacity(host vpcv1.DedicatedHost) dedicatedHostCapacityInfo {
+	capacity := dedicatedHostCapacityInfo{host: host}
+	if host.Vcpu != nil && host.Vcpu.Count != nil {
+		capacity.vcpuCount = *host.Vcpu.Count
+	}
+	if host.AvailableVcpu != nil && host.AvailableVcpu.Count != nil {
+		capacity.availableVcpuCount = *host.AvailableVcpu.Count
+	}
+	if host.Memory != nil {
+		capacity.memory = *host.Memory
+	}
+	if host.AvailableMemory != nil {
+		capacity.availableMemory = *host.AvailableMemory
+	}
+	return capacity
+}
+
+func (capacity dedicatedHostCapacityInfo) toMap() map[string]interface{} {
+	hostMap := map[string]interface{}{
+		"instance_count":       len(capacity.host.Instances),
+		"vcpu_count":           capacity.vcpuCount,
+		"available_vcpu_count": capacity.availableVcpuCount,
+		"memory":               capacity.memory,
+		"available_memory":     capacity.availableMemory,
+		"vcpu_utilization":     utilizationPercentage(capacity.vcpuCount, capacity.availableVcpuCount),
+		"memory_utilization":   utilizationPercentage(capacity.memory, capacity.availableMemory),
+	}
+	if capacity.host.ID != nil {
+		hostMap["id"] = *capacity.host.ID
+	}
+	if capacity.host.Name != nil {
+		hostMap["name"] = *capacity.host.Name
+	}
+	if capacity.host.InstancePlacementEnabled != nil {
+		hostMap["instance_placement_enabled"] = *capacity.host.InstancePlacementEnabled
+	}
+	return hostMap
+}
+
+// utilizationPercentage returns the percentage of total which is not
+// available, 0 when total is 0.
+func utilizationPercentage(total, available int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(total-available) * 100 / float64(total)
+}
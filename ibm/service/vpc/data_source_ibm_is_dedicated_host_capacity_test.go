@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIbmIsDedicatedHostCapacityDSBasic(t *testing.T) {
+	var conf vpcv1.DedicatedHost
+	groupname := fmt.Sprintf("tfgroup%d", acctest.RandIntRange(10, 100))
+	dhname := fmt.Sprintf("tfdhost%d", acctest.RandIntRange(10, 1000))
+	dhresName := "ibm_is_dedicated_host.dhost"
+	resName := "data.ibm_is_dedicated_host_capacity.capacity"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIsDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsDedicatedHostCapacityDSConfigBasic(acc.DedicatedHostGroupClass, acc.DedicatedHostGroupFamily, groupname, acc.DedicatedHostProfileName, dhname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIbmIsDedicatedHostExists(dhresName, conf),
+					resource.TestCheckResourceAttr(resName, "dedicated_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resName, "dedicated_hosts.0.name", dhname),
+					resource.TestCheckResourceAttr(resName, "dedicated_hosts.0.instance_count", "0"),
+					resource.TestCheckResourceAttr(resName, "vcpu_utilization", "0"),
+					resource.TestCheckResourceAttrSet(resName, "vcpu_count"),
+					resource.TestCheckResourceAttrSet(resName, "available_memory"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIsDedicatedHostCapacityDSConfigBasic(class string, family string, groupname string, profile string, dhname string) string {
+	return testAccCheckIbmIsDedicatedHostConfigBasic(class, family, groupname, profile, dhname) + `
+
+	data "ibm_is_dedicated_host_capacity" "capacity" {
+		host_group = ibm_is_dedicated_host.dhost.host_group
+	}
+	`
+}
@@ -6,9 +6,11 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -90,6 +92,32 @@ func DataSourceIBMISInstances() *schema.Resource {
 				Description:   "ID of the placement group to filter the instances attached to it",
 			},
 
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix of the name of the instances to filter",
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the zone to filter the instances in it",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Status of the instances to filter",
+			},
+
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "User tags to filter the instances having all of them",
+			},
+
 			isInstances: {
 				Type:        schema.TypeList,
 				Description: "List of instances",
@@ -1162,7 +1190,9 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	listInstancesOptions := &vpcv1.ListInstancesOptions{}
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		Limit: core.Int64Ptr(int64(100)),
+	}
 
 	if vpcName != "" {
 		listInstancesOptions.VPCName = &vpcName
@@ -1249,6 +1279,30 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecs[:i]
 	}
 
+	// The VPC API doesn't filter on these, so they are applied before any
+	// further call is made for each instance.
+	namePrefix := d.Get("name_prefix").(string)
+	zone := d.Get("zone").(string)
+	status := d.Get("status").(string)
+	if namePrefix != "" || zone != "" || status != "" {
+		i := 0
+		for _, ins := range allrecs {
+			if namePrefix != "" && !strings.HasPrefix(*ins.Name, namePrefix) {
+				continue
+			}
+			if zone != "" && (ins.Zone == nil || *ins.Zone.Name != zone) {
+				continue
+			}
+			if status != "" && *ins.Status != status {
+				continue
+			}
+			allrecs[i] = ins
+			i++
+		}
+		allrecs = allrecs[:i]
+	}
+	tagsFilter := d.Get("tags").(*schema.Set)
+
 	instancesInfo := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
 		id := *instance.ID
@@ -1341,6 +1395,9 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			log.Printf(
 				"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
 		}
+		if tagsFilter.Len() > 0 && !instancesHasAllTags(tags, tagsFilter) {
+			continue
+		}
 		l[isInstanceTags] = tags
 
 		accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
@@ -1600,6 +1657,18 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 }
 
 // dataSourceIBMISInstancesID returns a reasonable ID for a Instance list.
+func instancesHasAllTags(tags, wanted *schema.Set) bool {
+	if tags == nil {
+		return false
+	}
+	for _, tag := range wanted.List() {
+		if !tags.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
 func dataSourceIBMISInstancesID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
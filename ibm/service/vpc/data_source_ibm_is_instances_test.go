@@ -216,6 +216,41 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISInstancesDataSource_namezonefilter(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("tfins-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfins-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tfins-ssh-%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tfins-name-%d", acctest.RandIntRange(10, 100))
+	resName := "data.ibm_is_instances.ds_instances1"
+	userData := "a"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, instanceName, userData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancesDataSourceConfigNameZone(vpcname, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instances.0.name", instanceName),
+					resource.TestCheckResourceAttr(resName, "instances.0.zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr(resName, "instances.0.status", "running"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstancesDataSource_InsGroupfilter(t *testing.T) {
 
 	randInt := acctest.RandIntRange(10, 100)
@@ -276,6 +311,15 @@ func testAccCheckIBMISInstancesDataSourceConfig1(vpcname string) string {
 		vpc_name = "%s"
 	}`, vpcname)
 }
+func testAccCheckIBMISInstancesDataSourceConfigNameZone(vpcname, instanceName string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_instances" "ds_instances1" {
+		vpc_name    = "%s"
+		name_prefix = "%s"
+		zone        = "%s"
+		status      = "running"
+	}`, vpcname, instanceName, acc.ISZoneName)
+}
 func testAccCheckIBMISInstancesDataSourceConfigInstanceGroup(insGrpName string) string {
 	return fmt.Sprintf(`
 	data "ibm_is_instances" "ds_instances1" {
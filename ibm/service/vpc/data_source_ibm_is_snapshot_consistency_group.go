@@ -224,6 +224,11 @@ func DataSourceIBMIsSnapshotConsistencyGroup() *schema.Resource {
 							Computed:    true,
 							Description: "The resource type.",
 						},
+						"source_volume": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the volume this snapshot was created from, to restore the volume from the snapshot.",
+						},
 					},
 				},
 			},
@@ -368,6 +373,7 @@ func dataSourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *sch
 						if err != nil {
 							return diag.FromErr(err)
 						}
+						modelMap["source_volume"] = snapshotConsistencyGroupSnapshotSourceVolume(context, vpcClient, &modelItem)
 						snapshots = append(snapshots, modelMap)
 					}
 				}
@@ -463,6 +469,7 @@ func dataSourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *sch
 				if err != nil {
 					return diag.FromErr(err)
 				}
+				modelMap["source_volume"] = snapshotConsistencyGroupSnapshotSourceVolume(context, vpcClient, &modelItem)
 				snapshots = append(snapshots, modelMap)
 			}
 		}
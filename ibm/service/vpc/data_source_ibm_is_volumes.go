@@ -643,12 +643,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	operatingSystemFamily := d.Get(isVolumesOperatingSystemFamily).(string)
 	operatingSystemArch := d.Get(isVolumesOperatingSystemArch).(string)
 
-	start := ""
-	allrecs := []vpcv1.Volume{}
 	listVolumesOptions := &vpcv1.ListVolumesOptions{}
-	if start != "" {
-		listVolumesOptions.Start = &start
-	}
 	if volumeName != "" {
 		listVolumesOptions.Name = &volumeName
 	}
@@ -669,23 +664,19 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	}
 
 	// list
-	for {
+	allrecs, err := flex.GetAllPages(func(start string) ([]vpcv1.Volume, string, error) {
 		if start != "" {
 			listVolumesOptions.Start = &start
 		}
 		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response))
-		}
-
-		start = flex.GetNext(volumeCollection.Next)
-		allrecs = append(allrecs, volumeCollection.Volumes...)
-
-		if start == "" {
-			break
+			return nil, "", fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response)
 		}
-
+		return volumeCollection.Volumes, flex.GetNext(volumeCollection.Next), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dataSourceIBMIsVolumesID(d))
@@ -513,8 +513,6 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	start := ""
-	allrecs := []vpcv1.VPC{}
 	listOptions := &vpcv1.ListVpcsOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -524,21 +522,19 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		classicAccess := classicAccessIntf.(bool)
 		listOptions.ClassicAccess = &classicAccess
 	}
-	for {
-
+	allrecs, err := flex.GetAllPages(func(start string) ([]vpcv1.VPC, string, error) {
 		if start != "" {
 			listOptions.Start = &start
 		}
 		result, detail, err := sess.ListVpcsWithContext(context, listOptions)
 		if err != nil {
 			log.Printf("Error reading list of VPCs:%s\n%s", err, detail)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.Vpcs...)
-		if start == "" {
-			break
+			return nil, "", err
 		}
+		return result.Vpcs, flex.GetNext(result.Next), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	vpcs := make([]map[string]interface{}, 0)
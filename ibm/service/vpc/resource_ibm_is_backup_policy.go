@@ -24,6 +24,7 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 		UpdateContext: resourceIBMIsBackupPolicyUpdate,
 		DeleteContext: resourceIBMIsBackupPolicyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: flex.AccessTagsCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"match_resource_types": &schema.Schema{
@@ -83,6 +84,7 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 				Computed:    true,
 				Description: "The CRN for this backup policy.",
 			},
+			flex.AccessTags: flex.AccessTagsSchema(),
 			"href": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -140,6 +142,7 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Optional:    true,
+				ForceNew:    true,
 				MaxItems:    1,
 				Description: "The scope for this backup policy.",
 				Elem: &schema.Resource{
@@ -147,6 +150,7 @@ func ResourceIBMIsBackupPolicy() *schema.Resource {
 						"crn": &schema.Schema{
 							Type:        schema.TypeString,
 							Optional:    true,
+							ForceNew:    true,
 							Description: "The CRN for this enterprise.",
 						},
 						"id": &schema.Schema{
@@ -265,6 +269,10 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 	backupPolicy := backupPolicyIntf.(*vpcv1.BackupPolicy)
 	d.SetId(*backupPolicy.ID)
 
+	if err = flex.UpdateAccessTags(d, meta, *backupPolicy.CRN); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMIsBackupPolicyRead(context, d, meta)
 }
 
@@ -331,6 +339,9 @@ func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceDa
 		if err = d.Set("crn", backupPolicy.CRN); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 		}
+		if err = flex.ReadAccessTags(d, meta, *backupPolicy.CRN); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if backupPolicy.Href != nil {
@@ -433,6 +444,9 @@ func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.Resource
 			return diag.FromErr(fmt.Errorf("[ERROR] UpdateBackupPolicyWithContext failed %s\n%s", err, response))
 		}
 	}
+	if err = flex.UpdateAccessTags(d, meta, d.Get("crn").(string)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return resourceIBMIsBackupPolicyRead(context, d, meta)
 }
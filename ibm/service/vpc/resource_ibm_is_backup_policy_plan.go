@@ -476,6 +476,7 @@ func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.Reso
 	}
 
 	deleteOverCountBool := false
+	removeRemoteRegionPolicies := false
 	if d.HasChange("deletion_trigger") {
 		backupPolicyPlanDeletionTriggerPrototype := vpcv1.BackupPolicyPlanDeletionTriggerPatch{}
 		backupPolicyPlanDeletionTriggerPrototypeMap := d.Get("deletion_trigger.0").(map[string]interface{})
@@ -541,6 +542,7 @@ func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.Reso
 			remoteCopyPolicies = append(remoteCopyPolicies, *remoteCopyPoliciesItem)
 		}
 		patchVals.RemoteRegionPolicies = remoteCopyPolicies
+		removeRemoteRegionPolicies = len(remoteCopyPolicies) == 0
 		hasChange = true
 	}
 	updateBackupPolicyPlanOptions.SetIfMatch(d.Get("version").(string))
@@ -557,6 +559,10 @@ func resourceIBMIsBackupPolicyPlanUpdate(context context.Context, d *schema.Reso
 			backupPolicyPlanDeletionTrigger["delete_over_count"] = nil
 			backupPolicyPlanPatch["deletion_trigger"] = backupPolicyPlanDeletionTrigger
 		}
+		// an empty list is omitted by AsPatch, it is sent explicitly to remove the remote copies
+		if removeRemoteRegionPolicies {
+			backupPolicyPlanPatch["remote_region_policies"] = []map[string]interface{}{}
+		}
 
 		updateBackupPolicyPlanOptions.BackupPolicyPlanPatch = backupPolicyPlanPatch
 		_, response, err := vpcClient.UpdateBackupPolicyPlanWithContext(context, updateBackupPolicyPlanOptions)
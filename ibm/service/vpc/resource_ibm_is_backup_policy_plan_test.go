@@ -311,6 +311,13 @@ func TestAccIBMIsBackupPolicyPlanCRC(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan_crc", "remote_region_policy.0.region", "us-east"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccCheckIBMIsBackupPolicyPlanConfigRemoteCopyPoliciesRemoved(bakupPolicyName, vpcname, subnetname, sshname, volname, name, cronSpec, bakupPolicyPlanName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsBackupPolicyPlanExists("ibm_is_backup_policy_plan.is_backup_policy_plan_crc", conf),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.is_backup_policy_plan_crc", "remote_region_policy.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -426,6 +433,17 @@ func testAccCheckIBMIsBackupPolicyPlanConfigRemoteCopyPolicies(backupPolicyName,
 	}`, bakupPolicyPlanName, cronSpec, acc.BaasEncryptionkeyCRN)
 }
 
+func testAccCheckIBMIsBackupPolicyPlanConfigRemoteCopyPoliciesRemoved(backupPolicyName, vpcname, subnetname, sshname, volName, name, cronSpec, bakupPolicyPlanName string) string {
+	return testAccCheckIBMIsBackupPolicyConfigBasic(backupPolicyName, vpcname, subnetname, sshname, volName, name) + fmt.Sprintf(`
+	
+	  resource "ibm_is_backup_policy_plan" "is_backup_policy_plan_crc" {
+		depends_on  		= [ibm_is_instance.testacc_instance]
+		backup_policy_id 	= ibm_is_backup_policy.is_backup_policy.id
+		name            	= "%s"
+		cron_spec 			= "%s"
+	}`, bakupPolicyPlanName, cronSpec)
+}
+
 func testAccCheckIBMIsBackupPolicyPlanConfigRemoteCopyPoliciesNullDeleteOverCount(backupPolicyName, vpcname, subnetname, sshname, volName, name, cronSpec, bakupPolicyPlanName string) string {
 	return testAccCheckIBMIsBackupPolicyConfigBasic(backupPolicyName, vpcname, subnetname, sshname, volName, name) + fmt.Sprintf(`
 	
@@ -82,6 +82,7 @@ const (
 	isBareMetalServerStatusRunning                       = "running"
 	isBareMetalServerStatusPending                       = "pending"
 	isBareMetalServerStatusRestarting                    = "restarting"
+	isBareMetalServerStatusReinitializing                = "reinitializing"
 	isBareMetalServerStatusFailed                        = "failed"
 	isBareMetalServerAccessTags                          = "access_tags"
 	isBareMetalServerUserTagType                         = "user"
@@ -111,7 +112,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -1088,9 +1089,8 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 
 			isBareMetalServerUserData: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
-				Description: "User data given for the bare metal server",
+				Description: "User data given for the bare metal server, updating it reinitializes the bare metal server",
 			},
 
 			isBareMetalServerZone: {
@@ -1889,8 +1889,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if flex.HasTags(d, isBareMetalServerTags, meta) {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
@@ -3470,6 +3469,18 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// user data
+	if d.HasChange(isBareMetalServerUserData) && !d.IsNewResource() {
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		if err != nil {
+			return err
+		}
+		err = bareMetalServerReplaceInitialization(context, d, sess, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange(isBareMetalServerPrimaryNetworkInterface) {
 		nicId := d.Get("primary_network_interface.0.id").(string)
 		nicflag := false
@@ -3617,21 +3628,25 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	action := ""
+	if actionOk, ok := d.GetOk(isBareMetalServerAction); ok {
+		action = actionOk.(string)
+	}
 	if d.HasChange(isBareMetalServerAction) {
-		action := ""
-		if actionOk, ok := d.GetOk(isBareMetalServerAction); ok {
-			action = actionOk.(string)
-		}
 		if action == "start" {
-			isBareMetalServerStart(sess, d.Id(), d, 10)
+			_, err = isBareMetalServerStart(sess, d.Id(), d, 10)
 		} else if action == "stop" {
-			isBareMetalServerStop(sess, d.Id(), d, 10)
+			_, err = isBareMetalServerStop(sess, d.Id(), d, 10)
 		} else if action == "restart" {
-			isBareMetalServerRestart(sess, d.Id(), d, 10)
+			_, err = isBareMetalServerRestart(sess, d.Id(), d, 10)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
-	if flag || isServerStopped {
+	// a server stopped for the update is started again, unless it is meant to stay stopped
+	if (flag || isServerStopped) && action != "stop" {
 		isServerStopped, err = resourceStartServerIfStopped(id, "hard", d, context, sess, isServerStopped)
 		if err != nil {
 			return err
@@ -3986,6 +4001,68 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 	return isServerStopped, nil
 }
 
+// bareMetalServerReplaceInitialization reinitializes the stopped bare metal
+// server with its current image and keys and the configured user data, and
+// waits for the server to be stopped again.
+func bareMetalServerReplaceInitialization(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, id string) error {
+	getInitializationOptions := &vpcv1.GetBareMetalServerInitializationOptions{
+		ID: &id,
+	}
+	initialization, response, err := sess.GetBareMetalServerInitializationWithContext(context, getInitializationOptions)
+	if err != nil || initialization == nil {
+		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) initialization : %s\n%s", id, err, response)
+	}
+	replaceInitializationOptions := &vpcv1.ReplaceBareMetalServerInitializationOptions{
+		ID: &id,
+	}
+	if initialization.Image != nil {
+		replaceInitializationOptions.Image = &vpcv1.ImageIdentity{
+			ID: initialization.Image.ID,
+		}
+	}
+	keyobjs := make([]vpcv1.KeyIdentityIntf, 0, len(initialization.Keys))
+	for _, key := range initialization.Keys {
+		keyobjs = append(keyobjs, &vpcv1.KeyIdentity{
+			ID: key.ID,
+		})
+	}
+	replaceInitializationOptions.Keys = keyobjs
+	userdata := d.Get(isBareMetalServerUserData).(string)
+	replaceInitializationOptions.UserData = &userdata
+
+	_, response, err = sess.ReplaceBareMetalServerInitializationWithContext(context, replaceInitializationOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reinitializing Bare Metal Server (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForBareMetalServerReinitialized(sess, id, d.Timeout(schema.TimeoutUpdate))
+	return err
+}
+
+func isWaitForBareMetalServerReinitialized(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Bare Metal Server (%s) to be reinitialized.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerStatusReinitializing, isBareMetalServerStatusPending},
+		Target:  []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			getbmsoptions := &vpcv1.GetBareMetalServerOptions{
+				ID: &id,
+			}
+			bms, response, err := bmsC.GetBareMetalServer(getbmsoptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+			}
+			if *bms.Status == isBareMetalServerStatusFailed {
+				return bms, *bms.Status, fmt.Errorf("[ERROR] The Bare Metal Server %s failed to reinitialize", id)
+			}
+			return bms, *bms.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
 func resourceIBMIsBareMetalServerMapToBareMetalServerTrustedPlatformModulePrototype(modelMap map[string]interface{}) (*vpcv1.BareMetalServerTrustedPlatformModulePrototype, error) {
 	model := &vpcv1.BareMetalServerTrustedPlatformModulePrototype{}
 	// if modelMap[isBareMetalServerTrustedPlatformModuleEnabled] != nil {
@@ -7,13 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,6 +25,7 @@ const (
 	isFlowLogTarget                = "target"
 	isFlowLogResourceGroup         = "resource_group"
 	isFlowLogTargetType            = "resource_type"
+	isFlowLogTargetResourceType    = "target_type"
 	isFlowLogCreatedAt             = "created_at"
 	isFlowLogCrn                   = "crn"
 	isFlowLogLifecycleState        = "lifecycle_state"
@@ -53,7 +54,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -85,11 +86,17 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Description: "The target id that the flow log collector is to collect flow logs",
 			},
 
+			isFlowLogTargetResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the target: vpc, subnet, instance, network_interface, instance_network_attachment or virtual_network_interface",
+			},
+
 			isFlowLogActive: {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Indicates whether this collector is active",
+				Description: "Indicates whether this collector is active. Set it to false to pause the collection of flow logs without deleting the collector",
 			},
 
 			isFlowLogResourceGroup: {
@@ -262,8 +269,12 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	_, err = isWaitForFlowLogAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	if flex.HasTags(d, isFlowLogTags, meta) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
@@ -296,6 +307,10 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
 	}
 
@@ -331,6 +346,9 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 		targetIntf := flowlogCollector.Target
 		target := targetIntf.(*vpcv1.FlowLogCollectorTarget)
 		d.Set(isFlowLogTarget, *target.ID)
+		if target.ResourceType != nil {
+			d.Set(isFlowLogTargetResourceType, *target.ResourceType)
+		}
 	}
 
 	if flowlogCollector.StorageBucket != nil {
@@ -405,14 +423,17 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if d.HasChange(isFlowLogActive) || d.HasChange(isFlowLogName) {
-		active := d.Get(isFlowLogActive).(bool)
-		name := d.Get(isFlowLogName).(string)
 		updoptions := &vpcv1.UpdateFlowLogCollectorOptions{
 			ID: &ID,
 		}
-		flowLogCollectorPatchModel := &vpcv1.FlowLogCollectorPatch{
-			Active: &active,
-			Name:   &name,
+		flowLogCollectorPatchModel := &vpcv1.FlowLogCollectorPatch{}
+		if d.HasChange(isFlowLogActive) {
+			active := d.Get(isFlowLogActive).(bool)
+			flowLogCollectorPatchModel.Active = &active
+		}
+		if d.HasChange(isFlowLogName) {
+			name := d.Get(isFlowLogName).(string)
+			flowLogCollectorPatchModel.Name = &name
 		}
 		flowLogCollectorPatch, err := flowLogCollectorPatchModel.AsPatch()
 		if err != nil {
@@ -423,6 +444,10 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response)
 		}
+		_, err = isWaitForFlowLogAvailable(sess, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
 	}
 
 	return resourceIBMISFlowLogRead(d, meta)
@@ -440,14 +465,84 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	}
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
-	if err != nil && response.StatusCode != 404 {
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
 	}
+	_, err = isWaitForFlowLogDeleted(sess, ID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
 
 	d.SetId("")
 	return nil
 }
 
+func isWaitForFlowLogAvailable(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for flow log collector (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "updating", "waiting"},
+		Target:     []string{"stable", "suspended", "failed"},
+		Refresh:    isFlowLogRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	flowlogCollector, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	if *flowlogCollector.(*vpcv1.FlowLogCollector).LifecycleState == "failed" {
+		return flowlogCollector, fmt.Errorf("[ERROR] Flow log collector (%s) went into failed state", id)
+	}
+	return flowlogCollector, nil
+}
+
+func isFlowLogRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &vpcv1.GetFlowLogCollectorOptions{
+			ID: &id,
+		}
+		flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		}
+		return flowlogCollector, *flowlogCollector.LifecycleState, nil
+	}
+}
+
+func isWaitForFlowLogDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for flow log collector (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "suspended", "pending", "updating", "waiting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			getOptions := &vpcv1.GetFlowLogCollectorOptions{
+				ID: &id,
+			}
+			flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return flowlogCollector, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+			}
+			return flowlogCollector, *flowlogCollector.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -49,6 +49,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMISFlowLogExists("ibm_is_flow_log.test_flow_log", instance),
 					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "name", flowlogname),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "target_type", "instance"),
 				),
 			},
 			//update
@@ -60,6 +61,15 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "active", "false"),
 				),
 			},
+			//resume
+			{
+				Config: testAccCheckIBMISFlowLogConfig(vpcname, name, newflowlogname, sshname, publicKey, subnetname, serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISFlowLogExists("ibm_is_flow_log.test_flow_log", instance),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "active", "true"),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "lifecycle_state", "stable"),
+				),
+			},
 		},
 	},
 	)
@@ -89,6 +99,7 @@ func TestAccIBMISFlowLog_vni(t *testing.T) {
 					testAccCheckIBMISFlowLogExists("ibm_is_flow_log.test_flow_log", instance),
 					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "name", flowlogname),
 					resource.TestCheckResourceAttr("data.ibm_is_flow_log.is_flow_log_name", "target.0.resource_type", "virtual_network_interface"),
+					resource.TestCheckResourceAttr("ibm_is_flow_log.test_flow_log", "target_type", "virtual_network_interface"),
 				),
 			},
 		},
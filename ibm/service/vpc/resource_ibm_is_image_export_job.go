@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +29,11 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 		DeleteContext: ResourceIBMIsImageExportDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"image": {
 				Type:        schema.TypeString,
@@ -71,6 +77,12 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_image_export_job", "format"),
 				Description:  "The format to use for the exported image. If the image is encrypted, only `qcow2` is supported.",
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the image export job to succeed on creation, and fail if the job fails.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -224,6 +236,13 @@ func ResourceIBMIsImageExportCreate(context context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s/%s", *createImageExportJobOptions.ImageID, *imageExportJob.ID))
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = isWaitForImageExportJobCompleted(context, d, meta, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceIBMIsImageExportRead(context, d, meta)
 }
 
@@ -253,6 +272,9 @@ func ResourceIBMIsImageExportRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("GetImageExportJobWithContext failed %s\n%s", err, response))
 	}
 
+	if _, ok := d.GetOkExists("wait_for_completion"); !ok {
+		d.Set("wait_for_completion", false)
+	}
 	if err = d.Set("format", imageExportJob.Format); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting format: %s", err))
 	}
@@ -459,6 +481,47 @@ func ResourceIBMIsImageExportCloudObjectStorageObjectReferenceToMap(model *vpcv1
 	return modelMap, nil
 }
 
+func isWaitForImageExportJobCompleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"queued", "running"},
+		Target:     []string{"succeeded"},
+		Refresh:    isImageExportJobRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isImageExportJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		parts, err := flex.SepIdParts(id, "/")
+		if err != nil {
+			return nil, "", err
+		}
+		getImgExpJobOptions := &vpcv1.GetImageExportJobOptions{}
+
+		getImgExpJobOptions.SetImageID(parts[0])
+		getImgExpJobOptions.SetID(parts[1])
+
+		imageExportJob, response, err := vpcClient.GetImageExportJobWithContext(context, getImgExpJobOptions)
+		if err != nil {
+			return imageExportJob, "", fmt.Errorf("[ERROR] Error Getting Image export job: %s\n%s", err, response)
+		}
+		if *imageExportJob.Status == "failed" {
+			reasons := []string{}
+			for _, reason := range imageExportJob.StatusReasons {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", flex.StringValue(reason.Code), flex.StringValue(reason.Message)))
+			}
+			return imageExportJob, *imageExportJob.Status, fmt.Errorf("[ERROR] Image export job (%s) failed: %s", id, strings.Join(reasons, ", "))
+		}
+		return imageExportJob, *imageExportJob.Status, nil
+	}
+}
+
 func isWaitForImageExportJobDeleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image export job (%s) to be deleted.", id)
 
@@ -68,6 +68,26 @@ func TestAccIBMIsImageExportAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMIsImageExportWaitForCompletion(t *testing.T) {
+	var conf vpcv1.ImageExportJob
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsImageExportDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsImageExportConfigWaitForCompletion(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsImageExportExists("ibm_is_image_export_job.is_image_export", conf),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.is_image_export", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.is_image_export", "completed_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIsImageExportConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -80,6 +100,19 @@ func testAccCheckIBMIsImageExportConfigBasic() string {
 	`, acc.IsImage, acc.IsCosBucketName)
 }
 
+func testAccCheckIBMIsImageExportConfigWaitForCompletion() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_is_image_export_job" "is_image_export" {
+			image = "%s"
+			storage_bucket {
+				name = "%s"
+			}
+			wait_for_completion = true
+		}
+	`, acc.IsImage, acc.IsCosBucketName)
+}
+
 func testAccCheckIBMIsImageExportConfig(format string, name string) string {
 	return fmt.Sprintf(`
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -31,6 +32,7 @@ const (
 	isInstancePrimaryNetworkInterface = "primary_network_interface"
 	isInstanceNicName                 = "name"
 	isInstanceProfile                 = "profile"
+	isInstanceAllowStopForResize      = "allow_stop_for_resize"
 	isInstanceNicPortSpeed            = "port_speed"
 	isInstanceNicAllowIPSpoofing      = "allow_ip_spoofing"
 	isInstanceNicPrimaryIpv4Address   = "primary_ipv4_address"
@@ -179,12 +181,20 @@ func ResourceIBMISInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceAllowStopValidate(diff)
+				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceAvailabilityValidate(context, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -252,6 +262,12 @@ func ResourceIBMISInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Profile info",
 			},
+			isInstanceAllowStopForResize: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to true, a running instance is stopped to change its profile and started again once resized.",
+			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
 				Optional:     true,
@@ -1157,7 +1173,6 @@ func ResourceIBMISInstance() *schema.Resource {
 						isInstanceBootVolumeId: {
 							Type:          schema.TypeString,
 							Optional:      true,
-							ForceNew:      true,
 							Computed:      true,
 							RequiredWith:  []string{isInstanceZone, isInstanceProfile, isInstanceVPC},
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.volume_id", "boot_volume.0.snapshot", "boot_volume.0.snapshot_crn", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn"},
@@ -1183,7 +1198,6 @@ func ResourceIBMISInstance() *schema.Resource {
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "boot_volume.0.snapshot_crn", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate, "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id", "boot_volume.0.snapshot_crn"},
 							Optional:      true,
-							ForceNew:      true,
 							Computed:      true,
 						},
 						isInstanceVolumeSnapshotCrn: {
@@ -1192,7 +1206,6 @@ func ResourceIBMISInstance() *schema.Resource {
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "boot_volume.0.snapshot_crn", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate, "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id", "boot_volume.0.snapshot"},
 							Optional:      true,
-							ForceNew:      true,
 							Computed:      true,
 						},
 						isInstanceBootEncryption: {
@@ -2166,7 +2179,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return flex.SDKQuotaErrorf(err, response, "CreateInstance failed", "ibm_is_instance", "create", meta)
 	}
 	d.SetId(*instance.ID)
 
@@ -2178,8 +2191,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceTags, meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -2615,7 +2627,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return flex.SDKQuotaErrorf(err, response, "CreateInstance failed", "ibm_is_instance", "create", meta)
 	}
 	d.SetId(*instance.ID)
 
@@ -2627,8 +2639,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceTags, meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -3043,7 +3054,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return flex.SDKQuotaErrorf(err, response, "CreateInstance failed", "ibm_is_instance", "create", meta)
 	}
 	d.SetId(*instance.ID)
 
@@ -3055,8 +3066,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceTags, meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -3483,7 +3493,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return flex.SDKQuotaErrorf(err, response, "CreateInstance failed", "ibm_is_instance", "create", meta)
 	}
 	d.SetId(*instance.ID)
 
@@ -3495,8 +3505,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceTags, meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -3883,7 +3892,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return flex.SDKQuotaErrorf(err, response, "CreateInstance failed", "ibm_is_instance", "create", meta)
 	}
 	d.SetId(*instance.ID)
 
@@ -3895,8 +3904,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceTags, meta) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -5115,9 +5123,19 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// a boot volume created from a snapshot for the replacement already has
+	// the configured size and name
+	bootVolCreated := false
+	if (d.HasChange("boot_volume.0.volume_id") || d.HasChange("boot_volume.0.snapshot") || d.HasChange("boot_volume.0.snapshot_crn")) && !d.IsNewResource() {
+		bootVolCreated, err = instanceReplaceBootVolume(d, instanceC, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	bootVolSize := "boot_volume.0.size"
 
-	if d.HasChange(bootVolSize) && !d.IsNewResource() {
+	if d.HasChange(bootVolSize) && !d.IsNewResource() && !bootVolCreated {
 		old, new := d.GetChange(bootVolSize)
 		if new.(int) < old.(int) {
 			return fmt.Errorf("[ERROR] Error while updating boot volume size of the instance, only expansion is possible")
@@ -5192,7 +5210,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	bootVolName := "boot_volume.0.name"
-	if d.HasChange(bootVolName) && !d.IsNewResource() {
+	if d.HasChange(bootVolName) && !d.IsNewResource() && !bootVolCreated {
 		volId := d.Get("boot_volume.0.volume_id").(string)
 		volName := d.Get(bootVolName).(string)
 		updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
@@ -5250,29 +5268,41 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
-	if d.HasChange(isPlacementTargetDedicatedHost) || d.HasChange(isPlacementTargetDedicatedHostGroup) && !d.IsNewResource() {
+	if (d.HasChange(isPlacementTargetDedicatedHost) || d.HasChange(isPlacementTargetDedicatedHostGroup)) && !d.IsNewResource() {
 		dedicatedHost := d.Get(isPlacementTargetDedicatedHost).(string)
 		dedicatedHostGroup := d.Get(isPlacementTargetDedicatedHostGroup).(string)
-		actiontype := "stop"
 
 		if dedicatedHost == "" && dedicatedHostGroup == "" {
 			return fmt.Errorf("[ERROR] Error: Instances cannot be moved from private to public hosts")
 		}
 
-		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
+		// The placement target of an instance can only be changed while it is
+		// stopped, so a running instance is stopped, migrated and started again.
+		getinsOptions := &vpcv1.GetInstanceOptions{
+			ID: &id,
 		}
-		_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
-			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return err
+		wasRunning := instance.Status != nil && *instance.Status != isInstanceActionStatusStopped
+		if wasRunning {
+			actiontype := "stop"
+			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       &actiontype,
+			}
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil
+				}
+				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			}
+			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			if err != nil {
+				return err
+			}
 		}
 
 		updateOptions := &vpcv1.UpdateInstanceOptions{
@@ -5295,31 +5325,33 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch with total volume bandwidth for InstancePatch: %s", err)
+			return fmt.Errorf("[ERROR] Error calling asPatch with placement target for InstancePatch: %s", err)
 		}
 
 		updateOptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updateOptions)
+		_, response, err = instanceC.UpdateInstance(updateOptions)
 		if err != nil {
-			return err
+			return fmt.Errorf("[ERROR] Error migrating Instance (%s) to the dedicated host placement target: %s\n%s", id, err, response)
 		}
 
-		actiontype = "start"
-		createinsactoptions = &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
+		if wasRunning {
+			actiontype := "start"
+			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       &actiontype,
+			}
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil
+				}
+				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			}
+			_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return err
 		}
 	}
 
@@ -5855,7 +5887,8 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
 		}
 
-		if instance != nil && *instance.Status == "running" {
+		wasRunning := instance != nil && *instance.Status == "running"
+		if wasRunning {
 			actiontype := "stop"
 			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -5896,21 +5929,23 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
 		}
 
-		actiontype := "start"
-		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
+		if wasRunning {
+			actiontype := "start"
+			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       &actiontype,
+			}
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil
+				}
+				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			}
+			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
-		if err != nil {
-			return err
 		}
 
 	}
@@ -5941,6 +5976,179 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceIBMISInstanceAllowStopValidate fails the plan when a running
+// instance would have to be stopped to change its profile or replace its boot
+// volume and allow_stop_for_resize is false, so that no update is applied at
+// all.
+func resourceIBMISInstanceAllowStopValidate(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || diff.Get(isInstanceAllowStopForResize).(bool) {
+		return nil
+	}
+	if status, _ := diff.GetChange(isInstanceStatus); status.(string) != "running" {
+		return nil
+	}
+	if diff.HasChange(isInstanceProfile) {
+		return fmt.Errorf("the instance is running and must be stopped to change its profile, set %s to true to stop and start it again during the resize", isInstanceAllowStopForResize)
+	}
+	if diff.HasChange("boot_volume.0.volume_id") || diff.HasChange("boot_volume.0.snapshot") || diff.HasChange("boot_volume.0.snapshot_crn") {
+		return fmt.Errorf("the instance is running and must be stopped to replace its boot volume, set %s to true to stop and start it again during the replacement", isInstanceAllowStopForResize)
+	}
+	return nil
+}
+
+// resourceIBMISInstanceAvailabilityValidate fails the plan of an instance or
+// an instance template, when the provider sets validate_availability, if the
+// profile, the image or the zone being planned is not available in the
+// region, so that the apply doesn't stop half way. The available alternatives
+// are listed in the error. The values which are not known yet are checked by
+// the API during the apply.
+func resourceIBMISInstanceAvailabilityValidate(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	session, ok := meta.(conns.ClientSession)
+	if !ok || !session.ValidateAvailability() {
+		return nil
+	}
+	sess, err := session.VpcV1API()
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	if diff.HasChange(isInstanceProfile) && diff.NewValueKnown(isInstanceProfile) {
+		if profile := diff.Get(isInstanceProfile).(string); profile != "" {
+			if err := isInstanceProfileAvailable(context, sess, profile); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+	}
+	if diff.HasChange(isInstanceImage) && diff.NewValueKnown(isInstanceImage) {
+		if image := diff.Get(isInstanceImage).(string); image != "" {
+			if err := isImageAvailable(context, sess, image); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+	}
+	if diff.HasChange(isInstanceZone) && diff.NewValueKnown(isInstanceZone) {
+		if zone := diff.Get(isInstanceZone).(string); zone != "" {
+			bmxSess, err := session.BluemixSession()
+			if err != nil {
+				return err
+			}
+			if err := isZoneAvailable(context, sess, bmxSess.Config.Region, zone); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("the planned configuration is not available:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// isInstanceProfileAvailable checks that the instance profile exists in the
+// region, and lists the profiles of the same family when it doesn't.
+func isInstanceProfileAvailable(context context.Context, sess *vpcv1.VpcV1, name string) error {
+	getInstanceProfileOptions := &vpcv1.GetInstanceProfileOptions{
+		Name: &name,
+	}
+	_, response, err := sess.GetInstanceProfileWithContext(context, getInstanceProfileOptions)
+	if err == nil {
+		return nil
+	}
+	if response == nil || response.StatusCode != 404 {
+		return fmt.Errorf("[ERROR] Error getting instance profile (%s): %s\n%s", name, err, response)
+	}
+	profiles, response, err := sess.ListInstanceProfilesWithContext(context, &vpcv1.ListInstanceProfilesOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing instance profiles: %s\n%s", err, response)
+	}
+	family := strings.SplitN(name, "-", 2)[0] + "-"
+	var alternatives, all []string
+	for _, profile := range profiles.Profiles {
+		if profile.Name == nil {
+			continue
+		}
+		all = append(all, *profile.Name)
+		if strings.HasPrefix(*profile.Name, family) {
+			alternatives = append(alternatives, *profile.Name)
+		}
+	}
+	if len(alternatives) == 0 {
+		alternatives = all
+	}
+	return fmt.Errorf("instance profile %q is not available in this region, available profiles: %s", name, strings.Join(alternatives, ", "))
+}
+
+// isImageAvailable checks that the image exists and can be provisioned, and
+// lists the available public images with the same operating system family
+// when it can't.
+func isImageAvailable(context context.Context, sess *vpcv1.VpcV1, id string) error {
+	getImageOptions := &vpcv1.GetImageOptions{
+		ID: &id,
+	}
+	image, response, err := sess.GetImageWithContext(context, getImageOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return fmt.Errorf("image %q is not found in this region, the ibm_is_images data source lists the available images", id)
+		}
+		return fmt.Errorf("[ERROR] Error getting image (%s): %s\n%s", id, err, response)
+	}
+	if image.Status == nil || *image.Status == "available" || *image.Status == "deprecated" {
+		return nil
+	}
+	if image.OperatingSystem == nil || image.OperatingSystem.Family == nil {
+		return fmt.Errorf("image %q is %s and can't be used to provision an instance", id, *image.Status)
+	}
+	family := *image.OperatingSystem.Family
+	visibility := "public"
+	listImagesOptions := &vpcv1.ListImagesOptions{
+		Visibility: &visibility,
+	}
+	images, err := flex.GetAllPages(func(start string) ([]vpcv1.Image, string, error) {
+		if start != "" {
+			listImagesOptions.Start = &start
+		}
+		imageCollection, response, err := sess.ListImagesWithContext(context, listImagesOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error listing images: %s\n%s", err, response)
+		}
+		return imageCollection.Images, flex.GetNext(imageCollection.Next), nil
+	})
+	if err != nil {
+		return err
+	}
+	var alternatives []string
+	for _, alternative := range images {
+		if alternative.Status == nil || *alternative.Status != "available" || alternative.OperatingSystem == nil || alternative.OperatingSystem.Family == nil || *alternative.OperatingSystem.Family != family {
+			continue
+		}
+		alternatives = append(alternatives, fmt.Sprintf("%s (%s)", *alternative.Name, *alternative.ID))
+	}
+	return fmt.Errorf("image %q is %s and can't be used to provision an instance, available %s images: %s", id, *image.Status, family, strings.Join(alternatives, ", "))
+}
+
+// isZoneAvailable checks that the zone is available in the region, and lists
+// the available zones of the region when it isn't.
+func isZoneAvailable(context context.Context, sess *vpcv1.VpcV1, region, name string) error {
+	listRegionZonesOptions := &vpcv1.ListRegionZonesOptions{
+		RegionName: &region,
+	}
+	zones, response, err := sess.ListRegionZonesWithContext(context, listRegionZonesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the zones of region (%s): %s\n%s", region, err, response)
+	}
+	var alternatives []string
+	for _, zone := range zones.Zones {
+		if zone.Name == nil || zone.Status == nil || *zone.Status != "available" {
+			continue
+		}
+		if *zone.Name == name {
+			return nil
+		}
+		alternatives = append(alternatives, *zone.Name)
+	}
+	return fmt.Errorf("zone %q is not available in region %s, available zones: %s", name, region, strings.Join(alternatives, ", "))
+}
+
 func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err := instanceUpdate(d, meta)
@@ -5951,6 +6159,216 @@ func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceIBMisInstanceRead(d, meta)
 }
 
+// instanceReplaceBootVolume swaps the boot volume of the instance with the
+// configured volume, or with a new volume created from the configured
+// snapshot with the configured name, size and encryption key. The instance is
+// stopped during the swap and started again if it was running. The replaced
+// volume is deleted, as it would be with the instance, when its attachment
+// deletes the volume on instance delete and auto_delete_volume is still set.
+// On failure the state is not updated and a volume created for the swap is
+// deleted again.
+func instanceReplaceBootVolume(d *schema.ResourceData, instanceC *vpcv1.VpcV1, id string) (createdVol bool, err error) {
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		return createdVol, fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+	}
+	if instance.BootVolumeAttachment == nil || instance.BootVolumeAttachment.Volume == nil {
+		return createdVol, fmt.Errorf("[ERROR] Error replacing boot volume of Instance (%s): the instance has no boot volume", id)
+	}
+	bootVolAttID := *instance.BootVolumeAttachment.ID
+	oldVolID := *instance.BootVolumeAttachment.Volume.ID
+	oldVolName := ""
+	if instance.BootVolumeAttachment.Volume.Name != nil {
+		oldVolName = *instance.BootVolumeAttachment.Volume.Name
+	}
+	bootVolAtt, response, err := instanceC.GetInstanceVolumeAttachment(&vpcv1.GetInstanceVolumeAttachmentOptions{
+		InstanceID: &id,
+		ID:         &bootVolAttID,
+	})
+	if err != nil {
+		return createdVol, fmt.Errorf("[ERROR] Error getting Instance boot volume attachment : %s\n%s", err, response)
+	}
+	deleteOldVol := bootVolAtt.DeleteVolumeOnInstanceDelete != nil && *bootVolAtt.DeleteVolumeOnInstanceDelete && d.Get("boot_volume.0.auto_delete_volume").(bool)
+
+	newVolID := d.Get("boot_volume.0.volume_id").(string)
+	volName := d.Get("boot_volume.0.name").(string)
+	namedVol := false
+	attached := false
+	defer func() {
+		if err == nil {
+			return
+		}
+		d.Partial(true)
+		if createdVol && !attached {
+			response, delErr := instanceC.DeleteVolume(&vpcv1.DeleteVolumeOptions{
+				ID: &newVolID,
+			})
+			if delErr != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[ERROR] Error deleting boot volume (%s) created for Instance (%s): %s\n%s", newVolID, id, delErr, response)
+			}
+		}
+	}()
+
+	if !d.HasChange("boot_volume.0.volume_id") || newVolID == "" {
+		zone := d.Get(isInstanceZone).(string)
+		profile := d.Get("boot_volume.0.profile").(string)
+		if profile == "" {
+			profile = "general-purpose"
+		}
+		volTemplate := &vpcv1.VolumePrototype{
+			Zone: &vpcv1.ZoneIdentity{
+				Name: &zone,
+			},
+			Profile: &vpcv1.VolumeProfileIdentity{
+				Name: &profile,
+			},
+		}
+		if snapshotCrn := d.Get("boot_volume.0.snapshot_crn").(string); d.HasChange("boot_volume.0.snapshot_crn") && snapshotCrn != "" {
+			volTemplate.SourceSnapshot = &vpcv1.SnapshotIdentity{
+				CRN: &snapshotCrn,
+			}
+		} else {
+			snapshot := d.Get("boot_volume.0.snapshot").(string)
+			volTemplate.SourceSnapshot = &vpcv1.SnapshotIdentity{
+				ID: &snapshot,
+			}
+		}
+		// the name of the replaced volume is only free once it is deleted
+		if volName != "" && volName != oldVolName {
+			volTemplate.Name = &volName
+			namedVol = true
+		}
+		if size := int64(d.Get("boot_volume.0.size").(int)); size != 0 {
+			volTemplate.Capacity = &size
+		}
+		if enc := d.Get("boot_volume.0.encryption").(string); enc != "" {
+			volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+				CRN: &enc,
+			}
+		}
+		if v, ok := d.GetOk("boot_volume.0.tags"); ok {
+			volTemplate.UserTags = flex.ExpandStringList(v.(*schema.Set).List())
+		}
+		if rgrp, ok := d.GetOk(isInstanceResourceGroup); ok {
+			rg := rgrp.(string)
+			volTemplate.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &rg,
+			}
+		}
+		vol, response, err := instanceC.CreateVolume(&vpcv1.CreateVolumeOptions{
+			VolumePrototype: volTemplate,
+		})
+		if err != nil {
+			return createdVol, fmt.Errorf("[ERROR] Error creating boot volume from snapshot for Instance (%s): %s\n%s", id, err, response)
+		}
+		newVolID = *vol.ID
+		createdVol = true
+		_, err = isWaitForVolumeAvailable(instanceC, newVolID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return createdVol, err
+		}
+	}
+	if newVolID == oldVolID {
+		return createdVol, nil
+	}
+
+	wasRunning := *instance.Status == "running"
+	if wasRunning {
+		actiontype := "stop"
+		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &actiontype,
+		}
+		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		if err != nil {
+			return createdVol, fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+		}
+		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		if err != nil {
+			return createdVol, err
+		}
+	}
+
+	updateInstanceVolAttOptions := &vpcv1.UpdateInstanceVolumeAttachmentOptions{
+		InstanceID: &id,
+		ID:         &bootVolAttID,
+		VolumeAttachmentPatch: map[string]interface{}{
+			"volume": map[string]interface{}{
+				"id": newVolID,
+			},
+		},
+	}
+	_, response, err = instanceC.UpdateInstanceVolumeAttachment(updateInstanceVolAttOptions)
+	if err != nil {
+		return createdVol, fmt.Errorf("[ERROR] Error replacing boot volume of Instance (%s) with volume (%s): %s\n%s", id, newVolID, err, response)
+	}
+	attached = true
+	_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, bootVolAttID)
+	if err != nil {
+		return createdVol, err
+	}
+
+	if wasRunning {
+		actiontype := "start"
+		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &actiontype,
+		}
+		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		if err != nil {
+			return createdVol, fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+		}
+		_, err = isWaitForInstanceAvailable(instanceC, id, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return createdVol, err
+		}
+	}
+
+	if deleteOldVol {
+		response, err = instanceC.DeleteVolume(&vpcv1.DeleteVolumeOptions{
+			ID: &oldVolID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return createdVol, fmt.Errorf("[ERROR] Error deleting replaced boot volume (%s) of Instance (%s): %s\n%s", oldVolID, id, err, response)
+		}
+		_, err = isWaitForVolumeDeleted(instanceC, oldVolID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return createdVol, err
+		}
+	}
+
+	if createdVol && volName != "" && !namedVol && !deleteOldVol {
+		log.Printf("[WARN] The name %s is still used by the replaced boot volume (%s) of Instance (%s), which is kept, the new boot volume (%s) is not renamed", volName, oldVolID, id, newVolID)
+	}
+	if createdVol && volName != "" && !namedVol && deleteOldVol {
+		volPatchModel := &vpcv1.VolumePatch{
+			Name: &volName,
+		}
+		volPatchModelAsPatch, err := volPatchModel.AsPatch()
+		if err != nil {
+			return createdVol, fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume name update of instance %s", err)
+		}
+		vol, res, err := instanceC.UpdateVolume(&vpcv1.UpdateVolumeOptions{
+			ID:          &newVolID,
+			VolumePatch: volPatchModelAsPatch,
+		})
+		if vol == nil || err != nil {
+			return createdVol, fmt.Errorf("[ERROR] Error encountered while updating name of boot volume of instance %s\n%s", err, res)
+		}
+	}
+
+	// the updates of the boot volume that follow apply to the new volume
+	bootVol := d.Get(isInstanceBootVolume).([]interface{})
+	if len(bootVol) > 0 && bootVol[0] != nil {
+		bootVol[0].(map[string]interface{})[isInstanceBootVolumeId] = newVolID
+		d.Set(isInstanceBootVolume, bootVol)
+	}
+	return createdVol, nil
+}
+
 func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -207,6 +208,16 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupID:               &instanceGroupID,
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
+
+		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
+		conns.IbmMutexKV.Lock(isInsGrpKey)
+		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+
+		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
+		if healthError != nil {
+			return healthError
+		}
+
 		instanceGroupManagerIntf, response, err := sess.CreateInstanceGroupManager(&createInstanceGroupManagerOptions)
 		if err != nil || instanceGroupManagerIntf == nil {
 			return fmt.Errorf("[ERROR] Error creating InstanceGroup manager: %s\n%s", err, response)
@@ -254,6 +265,10 @@ func resourceIBMISInstanceGroupManagerCreate(d *schema.ResourceData, meta interf
 			InstanceGroupManagerPrototype: &instanceGroupManagerPrototype,
 		}
 
+		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
+		conns.IbmMutexKV.Lock(isInsGrpKey)
+		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+
 		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 		if healthError != nil {
 			return healthError
@@ -337,6 +352,10 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 
+		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
+		conns.IbmMutexKV.Lock(isInsGrpKey)
+		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+
 		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
 			return healthError
@@ -440,6 +459,10 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 		InstanceGroupID: &instanceGroupID,
 	}
 
+	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
+	conns.IbmMutexKV.Lock(isInsGrpKey)
+	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
 		return healthError
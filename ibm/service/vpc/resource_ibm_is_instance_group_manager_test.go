@@ -75,6 +75,38 @@ func TestAccIBMISInstanceGroupManager_basic_scheduled(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroupManager_autoscaleAndScheduled(t *testing.T) {
+	randInt := acctest.RandIntRange(200, 300)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	instanceGroupManager := fmt.Sprintf("testinstancegroupmanager%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGroupManagerConfigAutoscaleAndScheduled(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.autoscale", "manager_type", "autoscale"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.scheduled", "manager_type", "scheduled"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager_action.action", "cron_spec", "*/5 1,2,3 * * *"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_instance_group_manager_action.action", "target_manager"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceGroupManagerDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -205,3 +237,71 @@ func testAccCheckIBMISInstanceGroupManagerConfigScheduled(vpcName, subnetName, s
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager)
 
 }
+
+func testAccCheckIBMISInstanceGroupManagerConfigAutoscaleAndScheduled(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%s"
+	   image   = "r006-14140f94-fcc4-11e9-96e7-a72723715315"
+	   profile = "bx2-8x32"
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	 }
+
+	resource "ibm_is_instance_group" "instance_group" {
+		name =  "%s"
+		instance_template = ibm_is_instance_template.instancetemplate1.id
+		instance_count =  2
+		subnets = [ibm_is_subnet.subnet2.id]
+	}
+
+	resource "ibm_is_instance_group_manager" "autoscale" {
+		name                 = "%s-autoscale"
+		instance_group       = ibm_is_instance_group.instance_group.id
+		manager_type         = "autoscale"
+		aggregation_window   = 120
+		enable_manager       = true
+		max_membership_count = 2
+		min_membership_count = 1
+	}
+
+	resource "ibm_is_instance_group_manager" "scheduled" {
+		name           = "%s-scheduled"
+		instance_group = ibm_is_instance_group.instance_group.id
+		manager_type   = "scheduled"
+		enable_manager = true
+	}
+
+	resource "ibm_is_instance_group_manager_action" "action" {
+		name                   = "%s-action"
+		instance_group         = ibm_is_instance_group.instance_group.id
+		instance_group_manager = ibm_is_instance_group_manager.scheduled.manager_id
+		cron_spec              = "*/5 1,2,3 * * *"
+		target_manager         = ibm_is_instance_group_manager.autoscale.manager_id
+		max_membership_count   = 2
+		min_membership_count   = 1
+	}
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManager, instanceGroupManager)
+}
@@ -83,7 +83,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 
@@ -91,6 +91,10 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeAttachmentValidate(diff)
 				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceAvailabilityValidate(context, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
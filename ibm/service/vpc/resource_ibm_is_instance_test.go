@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -863,6 +864,8 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_instance.testacc_instance", "name", name),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "profile", acc.InstanceProfileNameUpdate),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "status", "running"),
 				),
 			},
 		},
@@ -1229,6 +1232,64 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISInstanceSnapshotRestore_inPlace(t *testing.T) {
+	var instanceRestoreID, bootVolumeSize string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	name2 := fmt.Sprintf("tf-instnace2-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	vsiRestore := fmt.Sprintf("tf-instancerestore-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceSnapshotRestoreForceNewConfig(vpcname, subnetname, sshname, publicKey, name, name2, name, vsiRestore),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_is_instance.testacc_instance_restore", "id", func(value string) error {
+						instanceRestoreID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("ibm_is_instance.testacc_instance_restore", "boot_volume.0.size", func(value string) error {
+						bootVolumeSize = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance_restore", "boot_volume.0.snapshot", "ibm_is_snapshot.testacc_snapshot", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceSnapshotRestoreForceNewConfig(vpcname, subnetname, sshname, publicKey, name, name2, name2, vsiRestore),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_is_instance.testacc_instance_restore", "id", func(value string) error {
+						if value != instanceRestoreID {
+							return fmt.Errorf("instance was recreated: %s, expected %s", value, instanceRestoreID)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance_restore", "boot_volume.0.snapshot", "ibm_is_snapshot.testacc_snapshot", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance_restore", "boot_volume.0.name", "boot-restore"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance_restore", "boot_volume.0.auto_delete_volume", "true"),
+					resource.TestCheckResourceAttrWith("ibm_is_instance.testacc_instance_restore", "boot_volume.0.size", func(value string) error {
+						if value != bootVolumeSize {
+							return fmt.Errorf("boot volume size is %s, expected %s", value, bootVolumeSize)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstance_Reservation(t *testing.T) {
 	var instance string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -1422,6 +1483,53 @@ func testAccCheckIBMISInstanceWithMetaConfig(vpcname, subnetname, sshname, publi
 	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, metadata_service_enabled, protocol, hop_limit)
 }
 
+func TestAccIBMISInstance_validateAvailability(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISInstanceValidateAvailabilityConfig(vpcname, subnetname, name, "bx2-1000x1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`instance profile "bx2-1000x1" is not available in this region, available profiles: bx2-`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceValidateAvailabilityConfig(vpcname, subnetname, name, profile string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		validate_availability = true
+	}
+
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, acc.IsImage, profile, acc.ISZoneName)
+}
+
 func testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, name, userData string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -2187,6 +2295,7 @@ func testAccCheckIBMISInstanceConfigWithProfile(vpcname, subnetname, sshname, pu
 		name    = "%s"
 		image   = "%s"
 		profile = "%s"
+		allow_stop_for_resize = true
 		primary_network_interface {
 		  subnet     = ibm_is_subnet.testacc_subnet.id
 		}
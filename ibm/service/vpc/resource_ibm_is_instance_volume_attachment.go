@@ -57,7 +57,7 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 		Schema: map[string]*schema.Schema{
@@ -412,8 +412,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceVolAttTags); ok || v != "" {
+	if flex.HasTags(d, isInstanceVolAttTags, meta) {
 		volAttRef := volAtt.(*vpcv1.VolumeAttachment)
 		oldList, newList := d.GetChange(isInstanceVolAttTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *volAttRef.Volume.CRN, "", isInstanceUserTagType)
@@ -0,0 +1,626 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBListenerPolicies = "policies"
+
+	// Highest priority a load balancer listener policy can have.
+	isLBListenerPoliciesMaxPriority = 10
+)
+
+// ResourceIBMISLBListenerPolicies owns all the policies of a load balancer
+// listener. The priority of each policy is its position in the list, so that
+// the policies can be reordered in one apply instead of renumbering many
+// ibm_is_lb_listener_policy resources against each other.
+// Policies are matched by name: a policy whose action or rules changed is
+// deleted and created again, the other changes are done in place.
+func ResourceIBMISLBListenerPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBListenerPoliciesCreate,
+		ReadContext:   resourceIBMISLBListenerPoliciesRead,
+		UpdateContext: resourceIBMISLBListenerPoliciesUpdate,
+		DeleteContext: resourceIBMISLBListenerPoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBListenerPolicyLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The load balancer identifier.",
+			},
+			isLBListenerPolicyListenerID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The load balancer listener identifier.",
+			},
+			isLBListenerPolicies: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    isLBListenerPoliciesMaxPriority,
+				Description: "The policies of the listener, in the order they are evaluated. Any policy of the listener that is not listed here is deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBListenerPolicyName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener_policy", isLBListenerPolicyName),
+							Description:  "The name of the policy. Names are unique within the listener.",
+						},
+						isLBListenerPolicyAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener_policy", isLBListenerPolicyAction),
+							Description:  "The policy action.",
+						},
+						"target": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "- If `action` is `forward`, the pool to forward to- If `action` is `redirect`, the URL and HTTP status code of the redirect- If `action` is `https_redirect`, the listener, HTTP status code and URI of the redirect.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The unique identifier of the load balancer pool.",
+									},
+									"http_status_code": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The HTTP status code for the redirect.",
+									},
+									"url": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The redirect target URL.",
+									},
+									"listener": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The unique identifier of the listener to redirect to.",
+									},
+									"uri": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The redirect relative target URI.",
+									},
+								},
+							},
+						},
+						isLBListenerPolicyRules: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The rules of the policy, all of them must match for the policy to apply.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isLBListenerPolicyRuleCondition: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener_policy_rule", isLBListenerPolicyRulecondition),
+										Description:  "Condition of the rule",
+									},
+									isLBListenerPolicyRuleType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_listener_policy_rule", isLBListenerPolicyRuleType),
+										Description:  "Type of the rule",
+									},
+									isLBListenerPolicyRuleValue: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ValidateStringLength,
+										Description:  "Value to be matched for rule condition",
+									},
+									isLBListenerPolicyRuleField: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateStringLength,
+										Description:  "HTTP header field. This is only applicable to rule type.",
+									},
+								},
+							},
+						},
+						isLBListenerPolicyPriority: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority of the policy, derived from its position in the list.",
+						},
+						isLBListenerPolicyID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the policy.",
+						},
+						isLBListenerPolicyStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMISLBListenerPoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBListenerPolicyLBID).(string)
+	listenerID := d.Get(isLBListenerPolicyListenerID).(string)
+	if err := lbListenerPoliciesReconcile(meta, lbID, listenerID, d.Get(isLBListenerPolicies).([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, listenerID))
+	return resourceIBMISLBListenerPoliciesRead(context, d, meta)
+}
+
+func resourceIBMISLBListenerPoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of lbID/listenerID", d.Id()))
+	}
+	lbID := parts[0]
+	listenerID := parts[1]
+
+	policies, response, err := lbListenerPoliciesList(sess, lbID, listenerID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	policyList := make([]map[string]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		policyMap, err := lbListenerPoliciesPolicyToMap(sess, lbID, listenerID, policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyList = append(policyList, policyMap)
+	}
+
+	d.Set(isLBListenerPolicyLBID, lbID)
+	d.Set(isLBListenerPolicyListenerID, listenerID)
+	if err = d.Set(isLBListenerPolicies, policyList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policies: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMISLBListenerPoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isLBListenerPolicies) {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = lbListenerPoliciesReconcile(meta, parts[0], parts[1], d.Get(isLBListenerPolicies).([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMISLBListenerPoliciesRead(context, d, meta)
+}
+
+func resourceIBMISLBListenerPoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = lbListenerPoliciesReconcile(meta, parts[0], parts[1], nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// lbListenerPoliciesList returns the policies of the listener sorted by
+// priority.
+func lbListenerPoliciesList(sess *vpcv1.VpcV1, lbID, listenerID string) ([]vpcv1.LoadBalancerListenerPolicy, *core.DetailedResponse, error) {
+	listLoadBalancerListenerPoliciesOptions := &vpcv1.ListLoadBalancerListenerPoliciesOptions{}
+	listLoadBalancerListenerPoliciesOptions.SetLoadBalancerID(lbID)
+	listLoadBalancerListenerPoliciesOptions.SetListenerID(listenerID)
+
+	collection, response, err := sess.ListLoadBalancerListenerPolicies(listLoadBalancerListenerPoliciesOptions)
+	if err != nil {
+		return nil, response, fmt.Errorf("[ERROR] Error listing policies of load balancer listener (%s): %s\n%s", listenerID, err, response)
+	}
+	policies := collection.Policies
+	sort.SliceStable(policies, func(i, j int) bool {
+		return flex.IntValue(policies[i].Priority) < flex.IntValue(policies[j].Priority)
+	})
+	return policies, response, nil
+}
+
+func lbListenerPoliciesReconcile(meta interface{}, lbID, listenerID string, desired []interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	current, _, err := lbListenerPoliciesList(sess, lbID, listenerID)
+	if err != nil {
+		return err
+	}
+	existing := map[string]vpcv1.LoadBalancerListenerPolicy{}
+	for _, policy := range current {
+		existing[flex.StringValue(policy.Name)] = policy
+	}
+
+	// A policy keeps its identity when its action and rules did not change,
+	// any other policy of the listener is deleted.
+	type keptPolicy struct {
+		id       string
+		priority int
+		target   map[string]interface{}
+		update   bool
+	}
+	kept := map[int]*keptPolicy{}
+	toCreate := []int{}
+	for i, p := range desired {
+		policyMap := p.(map[string]interface{})
+		name := policyMap[isLBListenerPolicyName].(string)
+		policy, ok := existing[name]
+		if ok && flex.StringValue(policy.Action) == policyMap[isLBListenerPolicyAction].(string) {
+			rules, err := lbListenerPoliciesRulesList(sess, lbID, listenerID, *policy.ID)
+			if err != nil {
+				return err
+			}
+			if lbListenerPoliciesRulesKey(rules) == lbListenerPoliciesRulesKey(policyMap[isLBListenerPolicyRules].(*schema.Set).List()) {
+				target := lbListenerPoliciesTargetMap(policyMap)
+				kept[i] = &keptPolicy{
+					id:       *policy.ID,
+					priority: flex.IntValue(policy.Priority),
+					target:   target,
+					update:   lbListenerPoliciesTargetKey(target) != lbListenerPoliciesTargetKey(lbListenerPoliciesTargetToMap(policy.Target)),
+				}
+				delete(existing, name)
+				continue
+			}
+		}
+		toCreate = append(toCreate, i)
+	}
+
+	for _, policy := range existing {
+		if err = lbListenerPoliciesDeletePolicy(sess, lbID, listenerID, *policy.ID, timeout); err != nil {
+			return err
+		}
+	}
+
+	// Priorities are unique within the listener, so a policy is only moved
+	// once its new priority is free. Policies moving in a cycle are first
+	// parked on a free priority, or recreated when there is none.
+	occupied := map[int]int{}
+	for i, p := range kept {
+		occupied[p.priority] = i
+	}
+	for {
+		pending := []int{}
+		for i, p := range kept {
+			if p.priority != i+1 || p.update {
+				pending = append(pending, i)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		sort.Ints(pending)
+
+		moved := false
+		for _, i := range pending {
+			p := kept[i]
+			if other, ok := occupied[i+1]; ok && other != i {
+				continue
+			}
+			if err = lbListenerPoliciesUpdatePolicy(sess, lbID, listenerID, p.id, i+1, p.target, p.update, timeout); err != nil {
+				return err
+			}
+			delete(occupied, p.priority)
+			p.priority = i + 1
+			p.update = false
+			occupied[p.priority] = i
+			moved = true
+		}
+		if moved {
+			continue
+		}
+
+		i := pending[0]
+		p := kept[i]
+		free := 0
+		for priority := isLBListenerPoliciesMaxPriority; priority > 0; priority-- {
+			if _, ok := occupied[priority]; !ok {
+				free = priority
+				break
+			}
+		}
+		if free == 0 {
+			if err = lbListenerPoliciesDeletePolicy(sess, lbID, listenerID, p.id, timeout); err != nil {
+				return err
+			}
+			delete(occupied, p.priority)
+			delete(kept, i)
+			toCreate = append(toCreate, i)
+			continue
+		}
+		if err = lbListenerPoliciesUpdatePolicy(sess, lbID, listenerID, p.id, free, nil, false, timeout); err != nil {
+			return err
+		}
+		delete(occupied, p.priority)
+		p.priority = free
+		occupied[free] = i
+	}
+
+	for _, i := range toCreate {
+		if err = lbListenerPoliciesCreatePolicy(sess, lbID, listenerID, i+1, desired[i].(map[string]interface{}), timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lbListenerPoliciesCreatePolicy(sess *vpcv1.VpcV1, lbID, listenerID string, priority int, policyMap map[string]interface{}, timeout time.Duration) error {
+	options := &vpcv1.CreateLoadBalancerListenerPolicyOptions{}
+	options.SetLoadBalancerID(lbID)
+	options.SetListenerID(listenerID)
+	options.SetName(policyMap[isLBListenerPolicyName].(string))
+	options.SetAction(policyMap[isLBListenerPolicyAction].(string))
+	options.SetPriority(int64(priority))
+
+	target := lbListenerPoliciesTargetMap(policyMap)
+	if target != nil {
+		targetModel, err := resourceIBMIsLbListenerPolicyMapToLoadBalancerListenerPolicyTargetPrototype(target)
+		if err != nil {
+			return err
+		}
+		options.SetTarget(targetModel)
+	}
+
+	rules := []vpcv1.LoadBalancerListenerPolicyRulePrototype{}
+	for _, r := range policyMap[isLBListenerPolicyRules].(*schema.Set).List() {
+		ruleMap := r.(map[string]interface{})
+		rule := vpcv1.LoadBalancerListenerPolicyRulePrototype{
+			Condition: core.StringPtr(ruleMap[isLBListenerPolicyRuleCondition].(string)),
+			Type:      core.StringPtr(ruleMap[isLBListenerPolicyRuleType].(string)),
+			Value:     core.StringPtr(ruleMap[isLBListenerPolicyRuleValue].(string)),
+		}
+		if field := ruleMap[isLBListenerPolicyRuleField].(string); field != "" {
+			rule.Field = core.StringPtr(field)
+		}
+		rules = append(rules, rule)
+	}
+	options.SetRules(rules)
+
+	_, err := isWaitForLbAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	log.Printf("[DEBUG] Creating policy %s with priority %d on load balancer listener %s", *options.Name, priority, listenerID)
+	policy, response, err := sess.CreateLoadBalancerListenerPolicy(options)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating load balancer listener policy (%s): %s\n%s", *options.Name, err, response)
+	}
+	_, err = isWaitForLbListenerPolicyAvailable(sess, fmt.Sprintf("%s/%s/%s", lbID, listenerID, *policy.ID), timeout)
+	return err
+}
+
+// lbListenerPoliciesUpdatePolicy sets the priority of the policy, and its
+// target too when updateTarget is true.
+func lbListenerPoliciesUpdatePolicy(sess *vpcv1.VpcV1, lbID, listenerID, id string, priority int, target map[string]interface{}, updateTarget bool, timeout time.Duration) error {
+	loadBalancerListenerPolicyPatchModel := &vpcv1.LoadBalancerListenerPolicyPatch{
+		Priority: core.Int64Ptr(int64(priority)),
+	}
+	uriRemoved := false
+	if updateTarget && target != nil {
+		targetPatch := &vpcv1.LoadBalancerListenerPolicyTargetPatch{}
+		if poolID := target["id"].(string); poolID != "" {
+			targetPatch.ID = core.StringPtr(poolID)
+		}
+		if code := target["http_status_code"].(int); code != 0 {
+			targetPatch.HTTPStatusCode = core.Int64Ptr(int64(code))
+		}
+		if url := target["url"].(string); url != "" {
+			targetPatch.URL = core.StringPtr(url)
+		}
+		if listener := target["listener"].(string); listener != "" {
+			targetPatch.Listener = &vpcv1.LoadBalancerListenerIdentity{
+				ID: core.StringPtr(listener),
+			}
+			if uri := target["uri"].(string); uri != "" {
+				targetPatch.URI = core.StringPtr(uri)
+			} else {
+				uriRemoved = true
+			}
+		}
+		loadBalancerListenerPolicyPatchModel.Target = targetPatch
+	}
+	loadBalancerListenerPolicyPatch, err := loadBalancerListenerPolicyPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
+	}
+	if uriRemoved {
+		loadBalancerListenerPolicyPatch["target"].(map[string]interface{})["uri"] = nil
+	}
+	updatePolicyOptions := &vpcv1.UpdateLoadBalancerListenerPolicyOptions{
+		LoadBalancerID:                  &lbID,
+		ListenerID:                      &listenerID,
+		ID:                              &id,
+		LoadBalancerListenerPolicyPatch: loadBalancerListenerPolicyPatch,
+	}
+
+	_, err = isWaitForLbAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	log.Printf("[DEBUG] Updating policy %s to priority %d on load balancer listener %s", id, priority, listenerID)
+	_, response, err := sess.UpdateLoadBalancerListenerPolicy(updatePolicyOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating load balancer listener policy (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForLbListenerPolicyAvailable(sess, fmt.Sprintf("%s/%s/%s", lbID, listenerID, id), timeout)
+	return err
+}
+
+func lbListenerPoliciesDeletePolicy(sess *vpcv1.VpcV1, lbID, listenerID, id string, timeout time.Duration) error {
+	_, err := isWaitForLbAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	log.Printf("[DEBUG] Deleting policy %s of load balancer listener %s", id, listenerID)
+	deleteLbListenerPolicyOptions := &vpcv1.DeleteLoadBalancerListenerPolicyOptions{
+		LoadBalancerID: &lbID,
+		ListenerID:     &listenerID,
+		ID:             &id,
+	}
+	response, err := sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting load balancer listener policy (%s): %s\n%s", id, err, response)
+	}
+	_, err = isWaitForLbListnerPolicyDeleted(sess, fmt.Sprintf("%s/%s/%s", lbID, listenerID, id), timeout)
+	return err
+}
+
+func lbListenerPoliciesRulesList(sess *vpcv1.VpcV1, lbID, listenerID, policyID string) ([]interface{}, error) {
+	listLoadBalancerListenerPolicyRulesOptions := &vpcv1.ListLoadBalancerListenerPolicyRulesOptions{}
+	listLoadBalancerListenerPolicyRulesOptions.SetLoadBalancerID(lbID)
+	listLoadBalancerListenerPolicyRulesOptions.SetListenerID(listenerID)
+	listLoadBalancerListenerPolicyRulesOptions.SetPolicyID(policyID)
+
+	collection, response, err := sess.ListLoadBalancerListenerPolicyRules(listLoadBalancerListenerPolicyRulesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing rules of load balancer listener policy (%s): %s\n%s", policyID, err, response)
+	}
+	rules := make([]interface{}, 0, len(collection.Rules))
+	for _, rule := range collection.Rules {
+		rules = append(rules, map[string]interface{}{
+			isLBListenerPolicyRuleCondition: flex.StringValue(rule.Condition),
+			isLBListenerPolicyRuleType:      flex.StringValue(rule.Type),
+			isLBListenerPolicyRuleField:     flex.StringValue(rule.Field),
+			isLBListenerPolicyRuleValue:     flex.StringValue(rule.Value),
+		})
+	}
+	return rules, nil
+}
+
+func lbListenerPoliciesRulesKey(rules []interface{}) string {
+	keys := make([]string, 0, len(rules))
+	for _, r := range rules {
+		ruleMap := r.(map[string]interface{})
+		keys = append(keys, fmt.Sprintf("%s/%s/%s/%s",
+			ruleMap[isLBListenerPolicyRuleCondition], ruleMap[isLBListenerPolicyRuleType],
+			ruleMap[isLBListenerPolicyRuleField], ruleMap[isLBListenerPolicyRuleValue]))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// lbListenerPoliciesTargetMap returns the target block of the policy, or nil
+// when it has none.
+func lbListenerPoliciesTargetMap(policyMap map[string]interface{}) map[string]interface{} {
+	targets := policyMap["target"].([]interface{})
+	if len(targets) == 0 || targets[0] == nil {
+		return nil
+	}
+	return targets[0].(map[string]interface{})
+}
+
+func lbListenerPoliciesTargetKey(target map[string]interface{}) string {
+	if target == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d/%s/%s/%s", target["id"], target["http_status_code"], target["url"], target["listener"], target["uri"])
+}
+
+func lbListenerPoliciesTargetToMap(model vpcv1.LoadBalancerListenerPolicyTargetIntf) map[string]interface{} {
+	if core.IsNil(model) {
+		return nil
+	}
+	targetMap := map[string]interface{}{
+		"id":               "",
+		"http_status_code": 0,
+		"url":              "",
+		"listener":         "",
+		"uri":              "",
+	}
+	switch target := model.(type) {
+	case *vpcv1.LoadBalancerListenerPolicyTargetLoadBalancerPoolReference:
+		targetMap["id"] = flex.StringValue(target.ID)
+	case *vpcv1.LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL:
+		targetMap["http_status_code"] = flex.IntValue(target.HTTPStatusCode)
+		targetMap["url"] = flex.StringValue(target.URL)
+	case *vpcv1.LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyHTTPSRedirect:
+		targetMap["http_status_code"] = flex.IntValue(target.HTTPStatusCode)
+		if target.Listener != nil {
+			targetMap["listener"] = flex.StringValue(target.Listener.ID)
+		}
+		targetMap["uri"] = flex.StringValue(target.URI)
+	case *vpcv1.LoadBalancerListenerPolicyTarget:
+		targetMap["id"] = flex.StringValue(target.ID)
+		targetMap["http_status_code"] = flex.IntValue(target.HTTPStatusCode)
+		targetMap["url"] = flex.StringValue(target.URL)
+		if target.Listener != nil {
+			targetMap["listener"] = flex.StringValue(target.Listener.ID)
+		}
+		targetMap["uri"] = flex.StringValue(target.URI)
+	default:
+		return nil
+	}
+	return targetMap
+}
+
+func lbListenerPoliciesPolicyToMap(sess *vpcv1.VpcV1, lbID, listenerID string, policy vpcv1.LoadBalancerListenerPolicy) (map[string]interface{}, error) {
+	rules, err := lbListenerPoliciesRulesList(sess, lbID, listenerID, *policy.ID)
+	if err != nil {
+		return nil, err
+	}
+	policyMap := map[string]interface{}{
+		isLBListenerPolicyName:     flex.StringValue(policy.Name),
+		isLBListenerPolicyAction:   flex.StringValue(policy.Action),
+		isLBListenerPolicyPriority: flex.IntValue(policy.Priority),
+		isLBListenerPolicyID:       flex.StringValue(policy.ID),
+		isLBListenerPolicyStatus:   flex.StringValue(policy.ProvisioningStatus),
+		isLBListenerPolicyRules:    rules,
+	}
+	if target := lbListenerPoliciesTargetToMap(policy.Target); target != nil {
+		policyMap["target"] = []interface{}{target}
+	}
+	return policyMap, nil
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISLBListenerPolicies_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpolicies-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpolicies-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tflbpolicies-lb-%d", acctest.RandIntRange(10, 100))
+	policyname := fmt.Sprintf("tflbpolicies-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBListenerPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBListenerPoliciesConfig(vpcname, subnetname, lbname, policyname, []string{"a", "b", "c"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.0.name", policyname+"-a"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.0.priority", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.2.priority", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBListenerPoliciesConfig(vpcname, subnetname, lbname, policyname, []string{"c", "a", "b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.0.name", policyname+"-c"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.0.priority", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.2.name", policyname+"-b"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBListenerPoliciesConfig(vpcname, subnetname, lbname, policyname, []string{"b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener_policies.testacc_policies", "policies.0.priority", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_lb_listener_policies.testacc_policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBListenerPoliciesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_lb_listener_policies" {
+			continue
+		}
+
+		idSet := strings.Split(rs.Primary.ID, "/")
+		listLoadBalancerListenerPoliciesOptions := &vpcv1.ListLoadBalancerListenerPoliciesOptions{
+			LoadBalancerID: &idSet[0],
+			ListenerID:     &idSet[1],
+		}
+		policies, _, err := sess.ListLoadBalancerListenerPolicies(listLoadBalancerListenerPoliciesOptions)
+		if err != nil {
+			continue
+		}
+		if len(policies.Policies) > 0 {
+			return fmt.Errorf("load balancer listener policy still exists: %s", *policies.Policies[0].ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISLBListenerPoliciesConfig(vpcname, subnetname, lbname, policyname string, order []string) string {
+	policies := ""
+	for _, suffix := range order {
+		policies += fmt.Sprintf(`
+	policies {
+		name   = "%s-%s"
+		action = "forward"
+		target {
+			id = ibm_is_lb_pool.testacc_pool.pool_id
+		}
+		rules {
+			condition = "contains"
+			type      = "path"
+			value     = "/%s"
+		}
+	}`, policyname, suffix, suffix)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+	resource "ibm_is_lb_pool" "testacc_pool" {
+		name           = "test"
+		lb             = ibm_is_lb.testacc_LB.id
+		algorithm      = "round_robin"
+		protocol       = "http"
+		health_delay   = 60
+		health_retries = 5
+		health_timeout = 30
+		health_type    = "http"
+	}
+	resource "ibm_is_lb_listener" "testacc_lb_listener" {
+		lb           = ibm_is_lb.testacc_LB.id
+		default_pool = ibm_is_lb_pool.testacc_pool.pool_id
+		port         = 8080
+		protocol     = "http"
+	}
+	resource "ibm_is_lb_listener_policies" "testacc_policies" {
+		lb       = ibm_is_lb.testacc_LB.id
+		listener = ibm_is_lb_listener.testacc_lb_listener.listener_id
+%s
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, policies)
+}
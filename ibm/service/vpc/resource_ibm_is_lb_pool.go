@@ -243,7 +243,14 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              persistanceType})
 
-	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema}
+	crossFieldRules := []validate.CrossFieldRule{
+		{
+			Type:        validate.RequiredWith,
+			Identifiers: []string{isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType},
+		},
+	}
+
+	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema, CrossFieldRules: crossFieldRules}
 	return &ibmISLBPoolResourceValidator
 }
 
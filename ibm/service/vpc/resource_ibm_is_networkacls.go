@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -65,7 +64,7 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -461,18 +460,12 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
 	nwaclid := *nwacl.ID
 
-	//Remove default rules
-	err = clearRules(sess, nwaclid)
+	//Replace the default rules
+	err = replaceRules(sess, nwaclid, rules)
 	if err != nil {
 		return err
 	}
-
-	err = createInlineRules(sess, nwaclid, rules)
-	if err != nil {
-		return err
-	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if flex.HasTags(d, isNetworkACLTags, meta) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
@@ -690,13 +683,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			return err
 		}
-		//Delete all existing rules
-		err = clearRules(sess, id)
-		if err != nil {
-			return err
-		}
-		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		err = replaceRules(sess, id, rules)
 		if err != nil {
 			return err
 		}
@@ -776,7 +763,12 @@ func checkNetworkACLNil(ptr *int64) int {
 	return int(*ptr)
 }
 
-func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
+type networkACLRuleRef struct {
+	id   string
+	name string
+}
+
+func listRules(nwaclC *vpcv1.VpcV1, nwaclid string) ([]networkACLRuleRef, error) {
 	start := ""
 	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	for {
@@ -788,7 +780,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
 		}
 		start = flex.GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -797,30 +789,132 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 	}
 
+	rules := make([]networkACLRuleRef, 0, len(allrecs))
 	for _, rule := range allrecs {
-		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
-			NetworkACLID: &nwaclid,
-		}
 		switch reflect.TypeOf(rule).String() {
 		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
 			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
-			deleteNetworkAclRuleOptions.ID = rule.ID
+			rules = append(rules, networkACLRuleRef{id: *rule.ID, name: *rule.Name})
 		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
 			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
-			deleteNetworkAclRuleOptions.ID = rule.ID
+			rules = append(rules, networkACLRuleRef{id: *rule.ID, name: *rule.Name})
 		case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
 			rule := rule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-			deleteNetworkAclRuleOptions.ID = rule.ID
+			rules = append(rules, networkACLRuleRef{id: *rule.ID, name: *rule.Name})
 		}
+	}
+	return rules, nil
+}
 
+func deleteRules(nwaclC *vpcv1.VpcV1, nwaclid string, ruleIDs []string) error {
+	for _, ruleID := range ruleIDs {
+		ruleID := ruleID
+		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: &nwaclid,
+			ID:           &ruleID,
+		}
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
 			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
 		}
 	}
 	return nil
 }
 
+func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
+	rules, err := listRules(nwaclC, nwaclid)
+	if err != nil {
+		return err
+	}
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.id)
+	}
+	return deleteRules(nwaclC, nwaclid, ruleIDs)
+}
+
+// replaceRules swaps the rules of the network ACL for the given ones without
+// leaving it empty: the new rules are inserted ahead of the existing ones,
+// which are deleted afterwards, so the traffic is always filtered by either
+// the complete old or the complete new rule list first.
+func replaceRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+	oldRules, err := listRules(nwaclC, nwaclid)
+	if err != nil {
+		return err
+	}
+	if len(oldRules) == 0 {
+		_, err = createInlineRules(nwaclC, nwaclid, rules, "")
+		return err
+	}
+
+	// Rule names are unique within a network ACL, rename the old rules the new
+	// ones clash with. Their names are restored if the new rules can't be
+	// created.
+	newNames := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		newNames[rule.(map[string]interface{})[isNetworkACLRuleName].(string)] = true
+	}
+	oldRuleIDs := make([]string, 0, len(oldRules))
+	renamedRules := make([]networkACLRuleRef, 0)
+	for _, oldRule := range oldRules {
+		oldRuleIDs = append(oldRuleIDs, oldRule.id)
+		if !newNames[oldRule.name] {
+			continue
+		}
+		if err := renameRule(nwaclC, nwaclid, oldRule.id, "replaced-"+oldRule.id); err != nil {
+			restoreRuleNames(nwaclC, nwaclid, renamedRules)
+			return err
+		}
+		renamedRules = append(renamedRules, oldRule)
+	}
+
+	newRuleIDs, err := createInlineRules(nwaclC, nwaclid, rules, oldRuleIDs[0])
+	if err != nil {
+		// Keep the old rules in effect.
+		if rollbackErr := deleteRules(nwaclC, nwaclid, newRuleIDs); rollbackErr != nil {
+			log.Printf("[WARN] Error removing the network ACL (%s) rules created before the failure: %s", nwaclid, rollbackErr)
+		} else {
+			restoreRuleNames(nwaclC, nwaclid, renamedRules)
+		}
+		return err
+	}
+	return deleteRules(nwaclC, nwaclid, oldRuleIDs)
+}
+
+func renameRule(nwaclC *vpcv1.VpcV1, nwaclid, ruleID, name string) error {
+	networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{
+		Name: &name,
+	}
+	networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err)
+	}
+	updateNetworkAclRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+		NetworkACLID:        &nwaclid,
+		ID:                  &ruleID,
+		NetworkACLRulePatch: networkACLRulePatch,
+	}
+	_, response, err := nwaclC.UpdateNetworkACLRule(updateNetworkAclRuleOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating network ACL rule(%s) : %s\n%s", ruleID, err, response)
+	}
+	return nil
+}
+
+// restoreRuleNames gives back their name to the rules renamed by
+// replaceRules. The rules left with a "replaced-" name are deleted by the
+// next update of the network ACL, as they aren't in the configuration.
+func restoreRuleNames(nwaclC *vpcv1.VpcV1, nwaclid string, rules []networkACLRuleRef) {
+	for _, rule := range rules {
+		if err := renameRule(nwaclC, nwaclid, rule.id, rule.name); err != nil {
+			log.Printf("[WARN] Error restoring the name of the network ACL (%s) rule %s: %s", nwaclid, rule.id, err)
+		}
+	}
+}
+
 func validateInlineRules(rules []interface{}) error {
 	for _, rule := range rules {
 		rulex := rule.(map[string]interface{})
@@ -844,8 +938,11 @@ func validateInlineRules(rules []interface{}) error {
 	return nil
 }
 
-func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
-	before := ""
+// createInlineRules creates the rules in order, ahead of the rule before when
+// set or at the end of the network ACL otherwise, and returns the ids of the
+// rules created.
+func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}, before string) ([]string, error) {
+	ruleIDs := make([]string, 0, len(rules))
 
 	for i := 0; i <= len(rules)-1; i++ {
 		rulex := rules[i].(map[string]interface{})
@@ -943,12 +1040,20 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 			NetworkACLID:            &nwaclid,
 			NetworkACLRulePrototype: ruleTemplate,
 		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+		rule, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			return ruleIDs, fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+		}
+		switch reflect.TypeOf(rule).String() {
+		case "*vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp":
+			ruleIDs = append(ruleIDs, *rule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp).ID)
+		case "*vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp":
+			ruleIDs = append(ruleIDs, *rule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp).ID)
+		case "*vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll":
+			ruleIDs = append(ruleIDs, *rule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll).ID)
 		}
 	}
-	return nil
+	return ruleIDs, nil
 }
 
 func isNil(i interface{}) bool {
@@ -6,12 +6,14 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -62,6 +64,10 @@ func ResourceIBMISReservation() *schema.Resource {
 		Delete:   resourceIBMISReservationDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			isReservationAffinityPolicy: &schema.Schema{
 				Type:         schema.TypeString,
@@ -386,6 +392,9 @@ func resourceIBMISReservationCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
 
 	reservation, response, err := sess.CreateReservation(createReservationOptions)
 	if err != nil {
@@ -660,10 +669,52 @@ func resourceIBMISReservationDelete(d *schema.ResourceData, meta interface{}) er
 	deleteReservationOptions := &vpcv1.DeleteReservationOptions{
 		ID: &id,
 	}
-	_, _, err = sess.DeleteReservation(deleteReservationOptions)
+	_, response, err := sess.DeleteReservation(deleteReservationOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Reservation : %s", err)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Deleting Reservation : %s\n%s", err, response)
+	}
+	_, err = isWaitForReservationDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
 	}
 	d.SetId("")
 	return nil
 }
+
+func isWaitForReservationDeleted(sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for reservation (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "stable", "updating", "pending", "waiting"},
+		Target:     []string{"done", ""},
+		Refresh:    isReservationDeleteRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isReservationDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getReservationOptions := &vpcv1.GetReservationOptions{
+			ID: &id,
+		}
+		reservation, response, err := sess.GetReservation(getReservationOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return reservation, "done", nil
+			}
+			return reservation, "", fmt.Errorf("[ERROR] Error getting reservation: %s\n%s", err, response)
+		}
+		if *reservation.LifecycleState == "failed" {
+			return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] The reservation %s failed to delete", id)
+		}
+		return reservation, *reservation.LifecycleState, nil
+	}
+}
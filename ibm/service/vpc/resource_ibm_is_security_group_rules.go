@@ -0,0 +1,421 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isSecurityGroupRulesAnyCIDR   = "0.0.0.0/0"
+	isSecurityGroupRulesProtoAll  = "all"
+	isSecurityGroupRulesICMPUnset = -1
+)
+
+// ResourceIBMISSecurityGroupRules owns every rule of a security group. Rules
+// have no identity of their own in the configuration, so they are matched
+// with the rules of the group by content: the configured rules that are
+// missing are created, then the rules of the group that are not configured
+// are deleted, including duplicates.
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISSecurityGroupRulesCreate,
+		Read:     resourceIBMISSecurityGroupRulesRead,
+		Update:   resourceIBMISSecurityGroupRulesUpdate,
+		Delete:   resourceIBMISSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMISSecurityGroupRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Security group id",
+			},
+			isSecurityGroupRules: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The rules of the security group. Any rule of the group that is not listed here is deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+							Description:  "IP version: ipv4",
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     isSecurityGroupRulesAnyCIDR,
+							Description: "Security group id, an IP address or a CIDR block of the remote side",
+						},
+						isSecurityGroupRuleLocal: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     isSecurityGroupRulesAnyCIDR,
+							Description: "An IP address or a CIDR block of the local side",
+						},
+						isSecurityGroupRuleProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRulesProtoAll,
+							ValidateFunc: validation.StringInSlice([]string{isSecurityGroupRulesProtoAll, isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP}, false),
+							Description:  "The protocol to enforce: all, icmp, tcp or udp",
+						},
+						isSecurityGroupRulePortMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+							Description:  "The inclusive lower bound of the TCP or UDP port range",
+						},
+						isSecurityGroupRulePortMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      65535,
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+							Description:  "The inclusive upper bound of the TCP or UDP port range",
+						},
+						isSecurityGroupRuleType: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isSecurityGroupRulesICMPUnset,
+							ValidateFunc: validation.IntBetween(isSecurityGroupRulesICMPUnset, 254),
+							Description:  "The ICMP traffic type to allow, -1 allows all types",
+						},
+						isSecurityGroupRuleCode: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      isSecurityGroupRulesICMPUnset,
+							ValidateFunc: validation.IntBetween(isSecurityGroupRulesICMPUnset, 255),
+							Description:  "The ICMP traffic code to allow, -1 allows all codes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMISSecurityGroupRulesCustomizeDiff rejects the configured rules
+// which are the same once the attributes that don't apply to their protocol
+// are ignored, as they would be matched with a single rule of the group.
+func resourceIBMISSecurityGroupRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(isSecurityGroupRules) {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, r := range diff.Get(isSecurityGroupRules).(*schema.Set).List() {
+		key := securityGroupRulesKey(r.(map[string]interface{}))
+		if seen[key] {
+			return fmt.Errorf("[ERROR] Duplicate rule %s in the rules of the security group, the rules must differ in the attributes which apply to their protocol", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	sgID := d.Get(isSecurityGroupID).(string)
+	if err := securityGroupRulesReconcile(d, meta, sgID, d.Get(isSecurityGroupRules).(*schema.Set).List()); err != nil {
+		return err
+	}
+	d.SetId(sgID)
+	return resourceIBMISSecurityGroupRulesRead(d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	sgID := d.Id()
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &sgID,
+	}
+	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s): %s\n%s", sgID, err, response)
+	}
+
+	rules, err := securityGroupRulesList(sess, sgID)
+	if err != nil {
+		return err
+	}
+	ruleList := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		_, ruleMap := securityGroupRulesRuleToMap(rule)
+		ruleList = append(ruleList, ruleMap)
+	}
+
+	d.Set(isSecurityGroupID, sgID)
+	if err = d.Set(isSecurityGroupRules, ruleList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(isSecurityGroupRules) {
+		if err := securityGroupRulesReconcile(d, meta, d.Id(), d.Get(isSecurityGroupRules).(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+	return resourceIBMISSecurityGroupRulesRead(d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := securityGroupRulesReconcile(d, meta, d.Id(), nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// securityGroupRulesReconcile makes the rules of the security group match
+// the desired rules, creating before deleting so that the traffic allowed by
+// both the old and the new rules is never blocked while a rule changes.
+func securityGroupRulesReconcile(d *schema.ResourceData, meta interface{}, sgID string, desired []interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	isSecurityGroupRuleKey := "security_group_rule_key_" + sgID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	wanted := map[string]int{}
+	wantedRules := map[string]map[string]interface{}{}
+	for _, r := range desired {
+		ruleMap := r.(map[string]interface{})
+		key := securityGroupRulesKey(ruleMap)
+		wanted[key]++
+		wantedRules[key] = ruleMap
+	}
+
+	current, err := securityGroupRulesList(sess, sgID)
+	if err != nil {
+		return err
+	}
+	toDelete := []string{}
+	for _, rule := range current {
+		ruleID, ruleMap := securityGroupRulesRuleToMap(rule)
+		key := securityGroupRulesKey(ruleMap)
+		if wanted[key] > 0 {
+			wanted[key]--
+			continue
+		}
+		toDelete = append(toDelete, ruleID)
+	}
+
+	keys := make([]string, 0, len(wanted))
+	for key := range wanted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for ; wanted[key] > 0; wanted[key]-- {
+			sgTemplate, err := securityGroupRulesPrototype(wantedRules[key])
+			if err != nil {
+				return err
+			}
+			options := &vpcv1.CreateSecurityGroupRuleOptions{
+				SecurityGroupID:            &sgID,
+				SecurityGroupRulePrototype: sgTemplate,
+			}
+			_, response, err := sess.CreateSecurityGroupRule(options)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+			}
+		}
+	}
+
+	for _, ruleID := range toDelete {
+		log.Printf("[DEBUG] Deleting rule %s of Security Group %s", ruleID, sgID)
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &sgID,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		}
+	}
+	return nil
+}
+
+func securityGroupRulesList(sess *vpcv1.VpcV1, sgID string) ([]vpcv1.SecurityGroupRuleIntf, error) {
+	listSecurityGroupRuleOptions := &vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: &sgID,
+	}
+	ruleList, response, err := sess.ListSecurityGroupRules(listSecurityGroupRuleOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error fetching security group rules %s\n%s", err, response)
+	}
+	return ruleList.Rules, nil
+}
+
+// securityGroupRulesKey identifies a rule by its content, with the same
+// defaults as the schema, so that a rule read from the API matches the
+// configured rule it was created from.
+func securityGroupRulesKey(rule map[string]interface{}) string {
+	protocol := rule[isSecurityGroupRuleProtocol].(string)
+	portMin, portMax := 1, 65535
+	icmpType, icmpCode := isSecurityGroupRulesICMPUnset, isSecurityGroupRulesICMPUnset
+	switch protocol {
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, portMax = rule[isSecurityGroupRulePortMin].(int), rule[isSecurityGroupRulePortMax].(int)
+	case isSecurityGroupRuleProtocolICMP:
+		icmpType, icmpCode = rule[isSecurityGroupRuleType].(int), rule[isSecurityGroupRuleCode].(int)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%d/%d/%d/%d",
+		rule[isSecurityGroupRuleDirection], rule[isSecurityGroupRuleIPVersion], protocol,
+		rule[isSecurityGroupRuleRemote], rule[isSecurityGroupRuleLocal],
+		portMin, portMax, icmpType, icmpCode)
+}
+
+func securityGroupRulesPrototype(rule map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, error) {
+	sgTemplate := &vpcv1.SecurityGroupRulePrototype{
+		Direction: flex.PtrToString(rule[isSecurityGroupRuleDirection].(string)),
+		IPVersion: flex.PtrToString(rule[isSecurityGroupRuleIPVersion].(string)),
+		Protocol:  flex.PtrToString(rule[isSecurityGroupRuleProtocol].(string)),
+	}
+
+	remoteAddress, remoteCIDR, remoteSecGrpID, err := inferRemoteSecurityGroup(rule[isSecurityGroupRuleRemote].(string))
+	if err != nil {
+		return nil, err
+	}
+	remoteTemplate := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if remoteAddress != "" {
+		remoteTemplate.Address = &remoteAddress
+	} else if remoteCIDR != "" {
+		remoteTemplate.CIDRBlock = &remoteCIDR
+	} else {
+		remoteTemplate.ID = &remoteSecGrpID
+	}
+	sgTemplate.Remote = remoteTemplate
+
+	localAddress, localCIDR, err := inferLocalSecurityGroup(rule[isSecurityGroupRuleLocal].(string))
+	if err != nil {
+		return nil, err
+	}
+	localTemplate := &vpcv1.SecurityGroupRuleLocalPrototype{}
+	if localAddress != "" {
+		localTemplate.Address = &localAddress
+	} else if localCIDR != "" {
+		localTemplate.CIDRBlock = &localCIDR
+	} else {
+		return nil, fmt.Errorf("[ERROR] Invalid local provided (%s), it must be an IP address or a CIDR block", rule[isSecurityGroupRuleLocal])
+	}
+	sgTemplate.Local = localTemplate
+
+	switch *sgTemplate.Protocol {
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin := int64(rule[isSecurityGroupRulePortMin].(int))
+		portMax := int64(rule[isSecurityGroupRulePortMax].(int))
+		sgTemplate.PortMin = &portMin
+		sgTemplate.PortMax = &portMax
+	case isSecurityGroupRuleProtocolICMP:
+		icmpType := int64(rule[isSecurityGroupRuleType].(int))
+		icmpCode := int64(rule[isSecurityGroupRuleCode].(int))
+		if icmpCode != isSecurityGroupRulesICMPUnset && icmpType == isSecurityGroupRulesICMPUnset {
+			return nil, fmt.Errorf("icmp code requires icmp type")
+		}
+		if icmpType != isSecurityGroupRulesICMPUnset {
+			sgTemplate.Type = &icmpType
+		}
+		if icmpCode != isSecurityGroupRulesICMPUnset {
+			sgTemplate.Code = &icmpCode
+		}
+	}
+	return sgTemplate, nil
+}
+
+// securityGroupRulesRuleToMap returns the ID of the rule and the rule in the
+// form of the rules schema.
+func securityGroupRulesRuleToMap(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	ruleMap := map[string]interface{}{
+		isSecurityGroupRuleIPVersion: isSecurityGroupRuleIPVersionDefault,
+		isSecurityGroupRuleRemote:    isSecurityGroupRulesAnyCIDR,
+		isSecurityGroupRuleLocal:     isSecurityGroupRulesAnyCIDR,
+		isSecurityGroupRulePortMin:   1,
+		isSecurityGroupRulePortMax:   65535,
+		isSecurityGroupRuleType:      isSecurityGroupRulesICMPUnset,
+		isSecurityGroupRuleCode:      isSecurityGroupRulesICMPUnset,
+	}
+	var ruleID, direction, ipVersion, protocol *string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	var local vpcv1.SecurityGroupRuleLocalIntf
+	switch rulex := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		ruleID, direction, ipVersion, protocol = rulex.ID, rulex.Direction, rulex.IPVersion, rulex.Protocol
+		remote, local = rulex.Remote, rulex.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		ruleID, direction, ipVersion, protocol = rulex.ID, rulex.Direction, rulex.IPVersion, rulex.Protocol
+		remote, local = rulex.Remote, rulex.Local
+		if rulex.Type != nil {
+			ruleMap[isSecurityGroupRuleType] = int(*rulex.Type)
+		}
+		if rulex.Code != nil {
+			ruleMap[isSecurityGroupRuleCode] = int(*rulex.Code)
+		}
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		ruleID, direction, ipVersion, protocol = rulex.ID, rulex.Direction, rulex.IPVersion, rulex.Protocol
+		remote, local = rulex.Remote, rulex.Local
+		if rulex.PortMin != nil {
+			ruleMap[isSecurityGroupRulePortMin] = int(*rulex.PortMin)
+		}
+		if rulex.PortMax != nil {
+			ruleMap[isSecurityGroupRulePortMax] = int(*rulex.PortMax)
+		}
+	}
+	ruleMap[isSecurityGroupRuleDirection] = flex.StringValue(direction)
+	ruleMap[isSecurityGroupRuleProtocol] = flex.StringValue(protocol)
+	if ipVersion != nil {
+		ruleMap[isSecurityGroupRuleIPVersion] = *ipVersion
+	}
+	if r, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && r != nil {
+		if r.ID != nil {
+			ruleMap[isSecurityGroupRuleRemote] = *r.ID
+		} else if r.Address != nil {
+			ruleMap[isSecurityGroupRuleRemote] = *r.Address
+		} else if r.CIDRBlock != nil {
+			ruleMap[isSecurityGroupRuleRemote] = *r.CIDRBlock
+		}
+	}
+	if l, ok := local.(*vpcv1.SecurityGroupRuleLocal); ok && l != nil {
+		if l.Address != nil {
+			ruleMap[isSecurityGroupRuleLocal] = *l.Address
+		} else if l.CIDRBlock != nil {
+			ruleMap[isSecurityGroupRuleLocal] = *l.CIDRBlock
+		}
+	}
+	return flex.StringValue(ruleID), ruleMap
+}
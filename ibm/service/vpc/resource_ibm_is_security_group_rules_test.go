@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrules-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 2),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.testacc_security_group_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISSecurityGroupRules_duplicate(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrules-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISSecurityGroupRulesDuplicateConfig(vpcname, name),
+				ExpectError: regexp.MustCompile("Duplicate rule"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_security_group_rules" {
+			continue
+		}
+
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err == nil && len(rules.Rules) != 0 {
+			return fmt.Errorf("security group rules still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != count {
+			return fmt.Errorf("security group %s has %d rules, expected %d", rs.Primary.ID, len(rules.Rules), count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name string, withICMP bool) string {
+	icmpRule := ""
+	if withICMP {
+		icmpRule = `
+		rules {
+		  direction = "inbound"
+		  remote    = "127.0.0.1"
+		  protocol  = "icmp"
+		  type      = 8
+		}`
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	  }
+
+	  resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		group = ibm_is_security_group.testacc_security_group.id
+		rules {
+		  direction = "inbound"
+		  remote    = "127.0.0.1"
+		  protocol  = "tcp"
+		  port_min  = 22
+		  port_max  = 22
+		}
+		rules {
+		  direction = "outbound"
+		}%s
+	  }`, vpcname, name, icmpRule)
+}
+
+func testAccCheckIBMISSecurityGroupRulesDuplicateConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	  }
+
+	  resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		group = ibm_is_security_group.testacc_security_group.id
+		rules {
+		  direction = "outbound"
+		}
+		rules {
+		  direction = "outbound"
+		  port_min  = 80
+		  port_max  = 80
+		}
+	  }`, vpcname, name)
+}
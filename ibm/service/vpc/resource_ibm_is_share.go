@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -39,10 +37,16 @@ func ResourceIbmIsShare() *schema.Resource {
 		DeleteContext: resourceIbmIsShareDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -1079,12 +1083,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 						userTagStr := userTag.(string)
 						userTagsArray[i] = userTagStr
 					}
-					schematicTags := os.Getenv("IC_ENV_TAGS")
-					var envTags []string
-					if schematicTags != "" {
-						envTags = strings.Split(schematicTags, ",")
-						userTagsArray = append(userTagsArray, envTags...)
-					}
+					userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 					replicaShare.UserTags = userTagsArray
 				}
 			}
@@ -1160,12 +1159,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 				userTagStr := userTag.(string)
 				userTagsArray[i] = userTagStr
 			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
+			userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 			sharePrototype.UserTags = userTagsArray
 		}
 	}
@@ -1919,12 +1913,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					userTagStr := userTag.(string)
 					userTagsArray[i] = userTagStr
 				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
+				userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 
 				sharePatchModel.UserTags = userTagsArray
 			}
@@ -1941,7 +1930,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		}
 		updateShareOptions.SetSharePatch(sharePatch)
 		if hasSizeChanged {
-			_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutCreate))
+			_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -1951,7 +1940,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 			log.Printf("[DEBUG] UpdateShareWithContext failed %s\n%s", err, response)
 			return err
 		}
-		_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -2037,7 +2026,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 					log.Printf("[DEBUG] UpdateShareTargetWithContext failed %s\n%s", err, response)
 					return err
 				}
-				_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutCreate))
+				_, err = WaitForVNIAvailable(vpcClient, *shareTarget.VirtualNetworkInterface.ID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -72,7 +70,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -595,12 +593,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 				userTagStr := userTag.(string)
 				userTagsArray[i] = userTagStr
 			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
+			userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 			if snapbyVolFlag {
 				snapshotprototypeoptions.UserTags = userTagsArray
 			} else {
@@ -900,12 +893,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 					userTagStr := userTag.(string)
 					userTagsArray[i] = userTagStr
 				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
+				userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 				snapshotPatchModel := &vpcv1.SnapshotPatch{}
 				snapshotPatchModel.UserTags = userTagsArray
 				snapshotPatch, err := snapshotPatchModel.AsPatch()
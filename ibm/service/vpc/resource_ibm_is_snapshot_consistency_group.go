@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,6 +28,12 @@ func ResourceIBMIsSnapshotConsistencyGroup() *schema.Resource {
 		DeleteContext: resourceIBMIsSnapshotConsistencyGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"delete_snapshots_on_delete": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -141,6 +145,11 @@ func ResourceIBMIsSnapshotConsistencyGroup() *schema.Resource {
 							Computed:    true,
 							Description: "The resource type.",
 						},
+						"source_volume": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the volume this snapshot was created from, to restore the volume from the snapshot.",
+						},
 					},
 				},
 			},
@@ -338,12 +347,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 				userTagStr := userTag.(string)
 				userTagsArray[i] = userTagStr
 			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
+			userTagsArray = flex.MergeEnvTags(userTagsArray, meta)
 			snapshotConsistencyGroupPrototypeSnapshotsItem.UserTags = userTagsArray
 		}
 		snapshotConsistencyGroupPrototypeSnapshotsItemArray = append(snapshotConsistencyGroupPrototypeSnapshotsItemArray, *snapshotConsistencyGroupPrototypeSnapshotsItem)
@@ -365,8 +369,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTags(d, "tags", meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -532,6 +535,7 @@ func resourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *schem
 			if err != nil {
 				return diag.FromErr(err)
 			}
+			snapshotsItemMap["source_volume"] = snapshotConsistencyGroupSnapshotSourceVolume(context, vpcClient, &snapshotsItem)
 			snapshots = append(snapshots, snapshotsItemMap)
 		}
 		if err = d.Set("snapshot_reference", snapshots); err != nil {
@@ -671,7 +675,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 			log.Printf("[DEBUG] UpdateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
 		}
-		_, err = isWaitForSnapshotConsistencyGroupUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForSnapshotConsistencyGroupUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -714,6 +718,27 @@ func resourceIBMIsSnapshotConsistencyGroupResourceGroupReferenceToMap(model *vpc
 	return modelMap, nil
 }
 
+// snapshotConsistencyGroupSnapshotSourceVolume returns the ID of the volume a
+// member snapshot was created from, which the snapshot reference does not
+// include, so that each volume can be restored from its own snapshot.
+func snapshotConsistencyGroupSnapshotSourceVolume(context context.Context, vpcClient *vpcv1.VpcV1, model *vpcv1.SnapshotReference) string {
+	if model.ID == nil || model.Deleted != nil || model.Remote != nil {
+		return ""
+	}
+	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+		ID: model.ID,
+	}
+	snapshot, response, err := vpcClient.GetSnapshotWithContext(context, getSnapshotOptions)
+	if err != nil {
+		log.Printf("[DEBUG] Error getting snapshot (%s) of snapshot consistency group: %s\n%s", *model.ID, err, response)
+		return ""
+	}
+	if snapshot.SourceVolume == nil || snapshot.SourceVolume.ID == nil {
+		return ""
+	}
+	return *snapshot.SourceVolume.ID
+}
+
 func resourceIBMIsSnapshotConsistencyGroupSnapshotConsistencyGroupSnapshotsItemToMap(model *vpcv1.SnapshotReference) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	modelMap["crn"] = model.CRN
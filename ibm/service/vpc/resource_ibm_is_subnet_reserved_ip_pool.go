@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isReservedIPPoolNamePrefix  = "name_prefix"
+	isReservedIPPoolIPCount     = "ip_count"
+	isReservedIPPoolTargets     = "targets"
+	isReservedIPPoolReservedIPs = "reserved_ips"
+)
+
+// ResourceIBMISSubnetReservedIPPool manages a block of reserved IPs of a
+// subnet, named <name_prefix>-<index> with the index starting at 1. The IPs
+// are identified by their index, so changing the count only creates or
+// deletes the IPs with the highest indexes.
+func ResourceIBMISSubnetReservedIPPool() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISSubnetReservedIPPoolCreate,
+		Read:     resourceIBMISSubnetReservedIPPoolRead,
+		Update:   resourceIBMISSubnetReservedIPPoolUpdate,
+		Delete:   resourceIBMISSubnetReservedIPPoolDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			isSubNetID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subnet identifier.",
+			},
+			isReservedIPPoolNamePrefix: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_subnet_reserved_ip_pool", isReservedIPPoolNamePrefix),
+				Description:  "The prefix of the names of the reserved IPs, which are named <name_prefix>-<index>.",
+			},
+			isReservedIPPoolIPCount: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 999),
+				Description:  "The number of reserved IPs in the pool.",
+			},
+			isReservedIPAutoDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the reserved IPs will be automatically deleted when their target is deleted.",
+			},
+			isReservedIPPoolTargets: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The endpoint gateways the reserved IPs are bound to, keyed by the name of the reserved IP.",
+			},
+			isReservedIPPoolReservedIPs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reserved IPs of the pool, ordered by index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isReservedIP: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the reserved IP.",
+						},
+						isReservedIPName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the reserved IP.",
+						},
+						isReservedIPAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the reserved IP.",
+						},
+						isReservedIPTarget: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the target of the reserved IP.",
+						},
+						isReservedIPLifecycleState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the reserved IP.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMISSubnetReservedIPPoolValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isReservedIPPoolNamePrefix,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             59})
+
+	ibmISSubnetReservedIPPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet_reserved_ip_pool", Schema: validateSchema}
+	return &ibmISSubnetReservedIPPoolResourceValidator
+}
+
+func resourceIBMISSubnetReservedIPPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	subnetID := d.Get(isSubNetID).(string)
+	namePrefix := d.Get(isReservedIPPoolNamePrefix).(string)
+
+	// Set id for the pool as combination of subnet ID and name prefix
+	d.SetId(fmt.Sprintf("%s/%s", subnetID, namePrefix))
+
+	err := reservedIPPoolReconcile(d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMISSubnetReservedIPPoolRead(d, meta)
+}
+
+func resourceIBMISSubnetReservedIPPoolRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	allIDs, err := flex.IdParts(d.Id())
+	if err != nil {
+		return fmt.Errorf("[ERROR] The ID can not be split into subnet ID and name prefix. %s", err)
+	}
+	subnetID := allIDs[0]
+	namePrefix := allIDs[1]
+
+	ips, response, err := reservedIPPoolList(sess, subnetID, namePrefix)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	indexes := make([]int, 0, len(ips))
+	for index := range ips {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	reservedIPs := []map[string]interface{}{}
+	targets := map[string]interface{}{}
+	autoDelete := d.Get(isReservedIPAutoDelete).(bool)
+	for _, index := range indexes {
+		rip := ips[index]
+		ipOutput := map[string]interface{}{
+			isReservedIP:        *rip.ID,
+			isReservedIPName:    *rip.Name,
+			isReservedIPAddress: *rip.Address,
+		}
+		if rip.LifecycleState != nil {
+			ipOutput[isReservedIPLifecycleState] = *rip.LifecycleState
+		}
+		if targetID := reservedIPPoolTargetID(rip); targetID != "" {
+			ipOutput[isReservedIPTarget] = targetID
+			if _, ok := rip.Target.(*vpcv1.ReservedIPTargetEndpointGatewayReference); ok {
+				targets[*rip.Name] = targetID
+			}
+		}
+		if rip.AutoDelete != nil {
+			autoDelete = *rip.AutoDelete
+		}
+		reservedIPs = append(reservedIPs, ipOutput)
+	}
+
+	d.Set(isSubNetID, subnetID)
+	d.Set(isReservedIPPoolNamePrefix, namePrefix)
+	d.Set(isReservedIPPoolIPCount, len(reservedIPs))
+	d.Set(isReservedIPAutoDelete, autoDelete)
+	d.Set(isReservedIPPoolTargets, targets)
+	d.Set(isReservedIPPoolReservedIPs, reservedIPs)
+	return nil
+}
+
+func resourceIBMISSubnetReservedIPPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(isReservedIPPoolIPCount) || d.HasChange(isReservedIPAutoDelete) || d.HasChange(isReservedIPPoolTargets) {
+		err := reservedIPPoolReconcile(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISSubnetReservedIPPoolRead(d, meta)
+}
+
+func resourceIBMISSubnetReservedIPPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	allIDs, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	subnetID := allIDs[0]
+	namePrefix := allIDs[1]
+
+	ips, response, err := reservedIPPoolList(sess, subnetID, namePrefix)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for _, rip := range ips {
+		err = reservedIPPoolDeleteIP(sess, subnetID, rip)
+		if err != nil {
+			return err
+		}
+	}
+	for _, rip := range ips {
+		err = reservedIPPoolWaitForIPDeleted(sess, subnetID, *rip.ID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// reservedIPPoolReconcile creates the missing reserved IPs of the pool,
+// deletes the ones beyond the count, and updates the auto delete setting and
+// the endpoint gateway bindings of the others.
+func reservedIPPoolReconcile(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	subnetID := d.Get(isSubNetID).(string)
+	namePrefix := d.Get(isReservedIPPoolNamePrefix).(string)
+	ipCount := d.Get(isReservedIPPoolIPCount).(int)
+	autoDelete := d.Get(isReservedIPAutoDelete).(bool)
+	targets := d.Get(isReservedIPPoolTargets).(map[string]interface{})
+
+	ips, _, err := reservedIPPoolList(sess, subnetID, namePrefix)
+	if err != nil {
+		return err
+	}
+
+	for index, rip := range ips {
+		if index > ipCount {
+			err = reservedIPPoolDeleteIP(sess, subnetID, rip)
+			if err != nil {
+				return err
+			}
+			err = reservedIPPoolWaitForIPDeleted(sess, subnetID, *rip.ID, timeout)
+			if err != nil {
+				return err
+			}
+			delete(ips, index)
+		}
+	}
+
+	for index := 1; index <= ipCount; index++ {
+		name := fmt.Sprintf("%s-%d", namePrefix, index)
+		rip, ok := ips[index]
+		if !ok {
+			options := sess.NewCreateSubnetReservedIPOptions(subnetID)
+			options.Name = &name
+			options.AutoDelete = &autoDelete
+			if targetID, ok := targets[name]; ok {
+				options.Target = &vpcv1.ReservedIPTargetPrototype{
+					ID: core.StringPtr(targetID.(string)),
+				}
+			}
+			created, response, err := sess.CreateSubnetReservedIP(options)
+			if err != nil || created == nil {
+				return fmt.Errorf("[ERROR] Error creating the reserved IP %s: %s\n%s", name, err, response)
+			}
+			err = reservedIPPoolWaitForIP(sess, subnetID, *created.ID, timeout)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if rip.AutoDelete != nil && *rip.AutoDelete != autoDelete {
+			patch := &vpcv1.ReservedIPPatch{AutoDelete: core.BoolPtr(autoDelete)}
+			reservedIPPatch, err := patch.AsPatch()
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating the reserved IP %s: %s", name, err)
+			}
+			options := &vpcv1.UpdateSubnetReservedIPOptions{
+				SubnetID:        &subnetID,
+				ID:              rip.ID,
+				ReservedIPPatch: reservedIPPatch,
+			}
+			_, response, err := sess.UpdateSubnetReservedIP(options)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating the reserved IP %s: %s\n%s", name, err, response)
+			}
+		}
+
+		currentGatewayID := ""
+		if _, ok := rip.Target.(*vpcv1.ReservedIPTargetEndpointGatewayReference); ok {
+			currentGatewayID = reservedIPPoolTargetID(rip)
+		}
+		targetGatewayID, _ := targets[name].(string)
+		if currentGatewayID == targetGatewayID {
+			continue
+		}
+		if currentGatewayID != "" {
+			response, err := sess.RemoveEndpointGatewayIP(sess.NewRemoveEndpointGatewayIPOptions(currentGatewayID, *rip.ID))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error unbinding the reserved IP %s from endpoint gateway %s: %s\n%s", name, currentGatewayID, err, response)
+			}
+		}
+		if targetGatewayID != "" {
+			_, response, err := sess.AddEndpointGatewayIP(sess.NewAddEndpointGatewayIPOptions(targetGatewayID, *rip.ID))
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error binding the reserved IP %s to endpoint gateway %s: %s\n%s", name, targetGatewayID, err, response)
+			}
+		}
+		err = reservedIPPoolWaitForIP(sess, subnetID, *rip.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservedIPPoolList returns the reserved IPs of the subnet which belong to
+// the pool, keyed by their index. The response of the failed request is
+// returned along with the error, so that a missing subnet can be told apart.
+func reservedIPPoolList(sess *vpcv1.VpcV1, subnetID, namePrefix string) (map[int]vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	nameRegexp := regexp.MustCompile("^" + regexp.QuoteMeta(namePrefix) + `-([1-9][0-9]*)$`)
+	ips := map[int]vpcv1.ReservedIP{}
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetReservedIpsOptions{SubnetID: &subnetID}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := sess.ListSubnetReservedIps(options)
+		if err != nil || result == nil {
+			return nil, response, fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
+		}
+		for _, rip := range result.ReservedIps {
+			if rip.Name == nil {
+				continue
+			}
+			if match := nameRegexp.FindStringSubmatch(*rip.Name); match != nil {
+				index, _ := strconv.Atoi(match[1])
+				ips[index] = rip
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return ips, nil, nil
+}
+
+func reservedIPPoolTargetID(rip vpcv1.ReservedIP) string {
+	switch target := rip.Target.(type) {
+	case *vpcv1.ReservedIPTargetEndpointGatewayReference:
+		return *target.ID
+	case *vpcv1.ReservedIPTargetNetworkInterfaceReferenceTargetContext:
+		return *target.ID
+	case *vpcv1.ReservedIPTargetLoadBalancerReference:
+		return *target.ID
+	case *vpcv1.ReservedIPTargetVPNGatewayReference:
+		return *target.ID
+	case *vpcv1.ReservedIPTarget:
+		if target.ID != nil {
+			return *target.ID
+		}
+	}
+	return ""
+}
+
+func reservedIPPoolDeleteIP(sess *vpcv1.VpcV1, subnetID string, rip vpcv1.ReservedIP) error {
+	response, err := sess.DeleteSubnetReservedIP(sess.NewDeleteSubnetReservedIPOptions(subnetID, *rip.ID))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting the reserved ip %s in subnet %s, %s\n%s", *rip.ID, subnetID, err, response)
+	}
+	return nil
+}
+
+func reservedIPPoolWaitForIP(sess *vpcv1.VpcV1, subnetID, id string, timeout time.Duration) error {
+	log.Printf("Waiting for reserved ip (%s/%s) to be available.", subnetID, id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			rip, response, err := sess.GetSubnetReservedIP(sess.NewGetSubnetReservedIPOptions(subnetID, id))
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetID, id, err, response)
+			}
+			if rip.LifecycleState != nil && *rip.LifecycleState == "failed" {
+				return rip, "failed", fmt.Errorf("[ERROR] Error Reserved ip(%s/%s) creation failed", subnetID, id)
+			}
+			if rip.LifecycleState == nil || *rip.LifecycleState == "stable" {
+				return rip, "done", nil
+			}
+			return rip, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the reserved IP to be available: %s", err)
+	}
+	return nil
+}
+
+func reservedIPPoolWaitForIPDeleted(sess *vpcv1.VpcV1, subnetID, id string, timeout time.Duration) error {
+	log.Printf("Waiting for reserved ip (%s/%s) to be deleted.", subnetID, id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			rip, response, err := sess.GetSubnetReservedIP(sess.NewGetSubnetReservedIPOptions(subnetID, id))
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return rip, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error Getting reserved ip(%s/%s) : %s\n%s", subnetID, id, err, response)
+			}
+			return rip, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the reserved IP to be deleted: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISSubnetReservedIPPoolResource_basic(t *testing.T) {
+	vpcName := fmt.Sprintf("tfresip-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfresip-subnet-%d", acctest.RandIntRange(10, 100))
+	egateway := fmt.Sprintf("tfresip-egateway-%d", acctest.RandIntRange(10, 100))
+	namePrefix := fmt.Sprintf("tfresip-pool-%d", acctest.RandIntRange(10, 100))
+	terraformTag := "ibm_is_subnet_reserved_ip_pool.pool1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckisSubnetReservedIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Tests create
+				Config: testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName, egateway, namePrefix, 3, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "ip_count", "3"),
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.#", "3"),
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.0.name", namePrefix+"-1"),
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.2.name", namePrefix+"-3"),
+				),
+			},
+			{
+				// Tests scale down and binding
+				Config: testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName, egateway, namePrefix, 2, namePrefix+"-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "ip_count", "2"),
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.#", "2"),
+					resource.TestCheckResourceAttr(terraformTag, "reserved_ips.1.name", namePrefix+"-2"),
+					resource.TestCheckResourceAttrPair(terraformTag, "reserved_ips.0.target", "ibm_is_virtual_endpoint_gateway.example", "id"),
+				),
+			},
+			{
+				ResourceName:      terraformTag,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckISSubnetReservedIPPoolConfig(vpcName, subnetName, egateway, namePrefix string, ipCount int, boundIPName string) string {
+	targets := ""
+	if boundIPName != "" {
+		targets = fmt.Sprintf(`
+		targets = {
+		  "%s" = ibm_is_virtual_endpoint_gateway.example.id
+		}`, boundIPName)
+	}
+	return fmt.Sprintf(`
+	  resource "ibm_is_vpc" "vpc1" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "subnet1" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.vpc1.id
+		zone                     = "%s"
+		total_ipv4_address_count = 256
+	  }
+
+	  resource "ibm_is_virtual_endpoint_gateway" "example" {
+		name = "%s"
+		target {
+		  name          = "ibm-ntp-server"
+		  resource_type = "provider_infrastructure_service"
+		}
+		vpc = ibm_is_vpc.vpc1.id
+	  }
+
+	  resource "ibm_is_subnet_reserved_ip_pool" "pool1" {
+		subnet      = ibm_is_subnet.subnet1.id
+		name_prefix = "%s"
+		ip_count    = %d
+		%s
+	  }
+	`, vpcName, subnetName, acc.ISZoneName, egateway, namePrefix, ipCount, targets)
+}
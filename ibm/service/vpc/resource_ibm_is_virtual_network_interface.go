@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -39,7 +40,7 @@ func ResourceIBMIsVirtualNetworkInterface() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -475,8 +476,11 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 	}
 
 	d.SetId(*virtualNetworkInterface.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if flex.HasTags(d, "tags", meta) {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isUserTagType)
 		if err != nil {
@@ -910,6 +914,10 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
 		}
+		_, err = isWaitForVirtualNetworkInterfaceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMIsVirtualNetworkInterfaceRead(context, d, meta)
@@ -927,9 +935,17 @@ func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *sche
 
 	response, err := sess.DeleteVirtualNetworkInterfacesWithContext(context, deleteVirtualNetworkInterfacesOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[DEBUG] DeleteVirtualNetworkInterfacesWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("DeleteVirtualNetworkInterfacesWithContext failed %s\n%s", err, response))
 	}
+	_, err = isWaitForVirtualNetworkInterfaceDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId("")
 
@@ -1108,6 +1124,40 @@ func isVirtualNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, id string) resour
 	}
 }
 
+func isWaitForVirtualNetworkInterfaceDeleted(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VirtualNetworkInterface (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "stable", "updating", "pending"},
+		Target:     []string{"done", ""},
+		Refresh:    isVirtualNetworkInterfaceDeleteRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isVirtualNetworkInterfaceDeleteRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vnigetoptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
+			ID: &id,
+		}
+		vni, response, err := client.GetVirtualNetworkInterface(vnigetoptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return vni, "done", nil
+			}
+			return vni, "", fmt.Errorf("[ERROR] Error getting vni: %s\n%s", err, response)
+		}
+		if *vni.LifecycleState == "failed" {
+			return vni, *vni.LifecycleState, fmt.Errorf("[ERROR] The VirtualNetworkInterface %s failed to delete", id)
+		}
+		return vni, *vni.LifecycleState, nil
+	}
+}
+
 func resourceIBMIsVirtualNetworkInterfaceVirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContextToMap(model *vpcv1.VirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContext) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	// if model.Deleted != nil {
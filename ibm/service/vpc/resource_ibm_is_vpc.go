@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -106,7 +105,7 @@ func ResourceIBMISVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -778,8 +777,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 			deleteDefaultSecurityGroupRules(sess, *vpc.ID)
 		}
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if flex.HasTags(d, isVPCTags, meta) {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
@@ -1446,7 +1444,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getVpcOptions := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVPC(getVpcOptions)
+	vpc, response, err := sess.GetVPC(getVpcOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -1454,6 +1452,13 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
 	}
+	// A DNS resolution binding cannot be deleted while the resolver is delegated through it.
+	if vpcDelegatedDnsResolverVPCID(vpc) != "" {
+		err = resetVPCDnsResolver(sess, id)
+		if err != nil {
+			return err
+		}
+	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
 		ID: &id,
@@ -1470,6 +1475,53 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
+// vpcDelegatedDnsResolverVPCID returns the id of the VPC the DNS resolver of
+// the vpc is delegated to, or "" when the resolver is not delegated.
+func vpcDelegatedDnsResolverVPCID(vpc *vpcv1.VPC) string {
+	if vpc.Dns == nil || vpc.Dns.Resolver == nil {
+		return ""
+	}
+	switch resolver := vpc.Dns.Resolver.(type) {
+	case *vpcv1.VpcdnsResolverTypeDelegated:
+		if resolver.VPC != nil && resolver.VPC.ID != nil {
+			return *resolver.VPC.ID
+		}
+	case *vpcv1.VpcdnsResolver:
+		if resolver.Type != nil && *resolver.Type == "delegated" && resolver.VPC != nil && resolver.VPC.ID != nil {
+			return *resolver.VPC.ID
+		}
+	}
+	return ""
+}
+
+// resetVPCDnsResolver switches the DNS resolver of the VPC back to system,
+// releasing the DNS resolution binding it is delegated through.
+func resetVPCDnsResolver(sess *vpcv1.VpcV1, id string) error {
+	vpcPatchModel := &vpcv1.VPCPatch{
+		Dns: &vpcv1.VpcdnsPatch{
+			Resolver: &vpcv1.VpcdnsResolverPatch{
+				Type: core.StringPtr("system"),
+			},
+		},
+	}
+	vpcPatch, err := vpcPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for VPCPatch: %s", err)
+	}
+	dnsMap := vpcPatch["dns"].(map[string]interface{})
+	resolverMap := dnsMap["resolver"].(map[string]interface{})
+	resolverMap["vpc"] = nil
+	updateVpcOptions := &vpcv1.UpdateVPCOptions{
+		ID:       &id,
+		VPCPatch: vpcPatch,
+	}
+	_, response, err := sess.UpdateVPC(updateVpcOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error resetting the DNS resolver of VPC (%s) to system : %s\n%s", id, err, response)
+	}
+	return nil
+}
+
 func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be deleted.", id)
 
@@ -456,6 +456,25 @@ func resourceIBMIsVPCDnsResolutionBindingDelete(context context.Context, d *sche
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// The binding of a spoke cannot be deleted while its resolver is delegated to the hub through it.
+	getVpcOptions := &vpcv1.GetVPCOptions{
+		ID: &vpcId,
+	}
+	vpc, response, err := sess.GetVPCWithContext(context, getVpcOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", vpcId, err, response))
+	}
+	if hubVPCID := vpcDelegatedDnsResolverVPCID(vpc); hubVPCID != "" && hubVPCID == d.Get("vpc.0.id").(string) {
+		err = resetVPCDnsResolver(sess, vpcId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	deleteVPCDnsResolutionBindingOptions := &vpcv1.DeleteVPCDnsResolutionBindingOptions{}
 
 	deleteVPCDnsResolutionBindingOptions.SetVPCID(vpcId)
@@ -464,9 +483,11 @@ func resourceIBMIsVPCDnsResolutionBindingDelete(context context.Context, d *sche
 	dns, response, err := sess.DeleteVPCDnsResolutionBindingWithContext(context, deleteVPCDnsResolutionBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-		if response.StatusCode != 404 {
+		if response == nil || response.StatusCode != 404 {
 			return diag.FromErr(fmt.Errorf("DeleteVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
 		}
+		d.SetId("")
+		return nil
 	}
 	_, err = isWaitForVpcDnsDeleted(sess, vpcId, id, d.Timeout(schema.TimeoutDelete), dns)
 	if err != nil {
@@ -0,0 +1,428 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rRoutes = "routes"
+
+	// Maximum number of routes created or deleted at the same time.
+	isRoutingTableRoutesConcurrency = 10
+)
+
+// ResourceIBMISVPCRoutingTableRoutes owns the user routes of a routing table.
+// The routes are matched with the routes of the table by content: a route of
+// the table whose destination, zone, next hop and action are configured is
+// updated in place when only its name, priority or advertise changed, the
+// other routes of the table are deleted and the missing configured routes are
+// created, all the deletions being done before the updates and creations.
+// Routes created by other resources, like VPN gateways, are left alone.
+func ResourceIBMISVPCRoutingTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCRoutingTableRoutesCreate,
+		Read:     resourceIBMISVPCRoutingTableRoutesRead,
+		Update:   resourceIBMISVPCRoutingTableRoutesUpdate,
+		Delete:   resourceIBMISVPCRoutingTableRoutesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			rtVpcID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			rtID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The routing table identifier.",
+			},
+			rRoutes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The user routes of the routing table. Any user route of the table that is not listed here is deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+							Description:  "The user-defined name for this route.",
+						},
+						rDestination: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "If action is deliver, the next hop that packets will be delivered to. For other action values, its address will be 0.0.0.0.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+							Description:  "The action to perform with a packet matching the route.",
+						},
+						"advertise": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", "priority"),
+							Description:  "The route's priority. Smaller values have higher priority.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMISVPCRoutingTableRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+	vpcID := d.Get(rtVpcID).(string)
+	tableID := d.Get(rtID).(string)
+	if err := vpcRoutingTableRoutesReconcile(meta, vpcID, tableID, d.Get(rRoutes).(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, tableID))
+	return resourceIBMISVPCRoutingTableRoutesRead(d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", d.Id())
+	}
+
+	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
+	_, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response)
+	}
+
+	routes, err := vpcRoutingTableRoutesList(sess, idSet[0], idSet[1])
+	if err != nil {
+		return err
+	}
+	routeList := make([]interface{}, 0, len(routes))
+	for _, route := range routes {
+		routeList = append(routeList, vpcRoutingTableRoutesRouteToMap(route))
+	}
+
+	d.Set(rtVpcID, idSet[0])
+	d.Set(rtID, idSet[1])
+	if err = d.Set(rRoutes, routeList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting routes: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(rRoutes) {
+		idSet := strings.Split(d.Id(), "/")
+		if err := vpcRoutingTableRoutesReconcile(meta, idSet[0], idSet[1], d.Get(rRoutes).(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCRoutingTableRoutesRead(d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+	idSet := strings.Split(d.Id(), "/")
+	if err := vpcRoutingTableRoutesReconcile(meta, idSet[0], idSet[1], nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// vpcRoutingTableRoutesList returns the routes of the routing table that were
+// created by users, the other routes cannot be deleted.
+func vpcRoutingTableRoutesList(sess *vpcv1.VpcV1, vpcID, tableID string) ([]vpcv1.Route, error) {
+	start := ""
+	allrecs := []vpcv1.Route{}
+	for {
+		listVpcRoutingTablesRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		if start != "" {
+			listVpcRoutingTablesRoutesOptions.Start = &start
+		}
+		result, response, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading list of VPC Routing Table Routes: %s\n%s", err, response)
+		}
+		start = flex.GetNext(result.Next)
+		for _, route := range result.Routes {
+			if route.Creator == nil && flex.StringValue(route.Origin) == "user" {
+				allrecs = append(allrecs, route)
+			}
+		}
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func vpcRoutingTableRoutesReconcile(meta interface{}, vpcID, tableID string, desired []interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string][]map[string]interface{}{}
+	for _, r := range desired {
+		routeMap := r.(map[string]interface{})
+		key := vpcRoutingTableRoutesIdentityKey(routeMap)
+		wanted[key] = append(wanted[key], routeMap)
+	}
+
+	current, err := vpcRoutingTableRoutesList(sess, vpcID, tableID)
+	if err != nil {
+		return err
+	}
+
+	// The routes which are configured as is are kept.
+	remaining := []vpcv1.Route{}
+	for _, route := range current {
+		routeMap := vpcRoutingTableRoutesRouteToMap(route)
+		key := vpcRoutingTableRoutesIdentityKey(routeMap)
+		if i := vpcRoutingTableRoutesIndex(wanted[key], routeMap); i >= 0 {
+			wanted[key] = append(wanted[key][:i], wanted[key][i+1:]...)
+			continue
+		}
+		remaining = append(remaining, route)
+	}
+
+	// The routes whose name, priority or advertise changed are updated, the
+	// other ones are deleted.
+	type routeUpdate struct {
+		id       string
+		routeMap map[string]interface{}
+	}
+	toUpdate := []routeUpdate{}
+	toDelete := []string{}
+	for _, route := range remaining {
+		key := vpcRoutingTableRoutesIdentityKey(vpcRoutingTableRoutesRouteToMap(route))
+		if len(wanted[key]) > 0 {
+			toUpdate = append(toUpdate, routeUpdate{id: *route.ID, routeMap: wanted[key][0]})
+			wanted[key] = wanted[key][1:]
+			continue
+		}
+		toDelete = append(toDelete, *route.ID)
+	}
+
+	// Routes are deleted first, and waited for, so that a changed route can be
+	// created again, or another route renamed, with the same name.
+	err = vpcRoutingTableRoutesRun(len(toDelete), func(i int) error {
+		log.Printf("[DEBUG] Deleting route %s of VPC Routing table %s", toDelete[i], tableID)
+		deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, toDelete[i])
+		response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting VPC Routing table route (%s): %s\n%s", toDelete[i], err, response)
+		}
+		return vpcRoutingTableRoutesWaitForDeleted(sess, vpcID, tableID, toDelete[i], timeout)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = vpcRoutingTableRoutesRun(len(toUpdate), func(i int) error {
+		routeMap := toUpdate[i].routeMap
+		log.Printf("[DEBUG] Updating route %s of VPC Routing table %s", toUpdate[i].id, tableID)
+		routePatchModel := &vpcv1.RoutePatch{
+			Name:      core.StringPtr(routeMap[rName].(string)),
+			Advertise: core.BoolPtr(routeMap["advertise"].(bool)),
+			Priority:  core.Int64Ptr(int64(routeMap["priority"].(int))),
+		}
+		routePatchModelAsPatch, err := routePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for VPC Routing Table Route Patch: %s", err)
+		}
+		updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, toUpdate[i].id, routePatchModelAsPatch)
+		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating VPC Routing table route (%s): %s\n%s", toUpdate[i].id, err, response)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	toCreate := make([]map[string]interface{}, 0, len(desired))
+	for _, routeMaps := range wanted {
+		toCreate = append(toCreate, routeMaps...)
+	}
+	return vpcRoutingTableRoutesRun(len(toCreate), func(i int) error {
+		routeMap := toCreate[i]
+		createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, routeMap[rDestination].(string), &vpcv1.ZoneIdentityByName{
+			Name: core.StringPtr(routeMap[rZone].(string)),
+		})
+		nextHop := routeMap[rNextHop].(string)
+		if net.ParseIP(nextHop) == nil {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeVPNGatewayConnectionIdentity{
+				ID: core.StringPtr(nextHop),
+			})
+		} else {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeRouteNextHopIP{
+				Address: core.StringPtr(nextHop),
+			})
+		}
+		createVpcRoutingTableRouteOptions.SetName(routeMap[rName].(string))
+		createVpcRoutingTableRouteOptions.SetAction(routeMap[rAction].(string))
+		createVpcRoutingTableRouteOptions.SetAdvertise(routeMap["advertise"].(bool))
+		createVpcRoutingTableRouteOptions.SetPriority(int64(routeMap["priority"].(int)))
+
+		_, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating VPC Routing table route (%s): %s\n%s", routeMap[rName], err, response)
+		}
+		return nil
+	})
+}
+
+func vpcRoutingTableRoutesWaitForDeleted(sess *vpcv1.VpcV1, vpcID, tableID, routeID string, timeout time.Duration) error {
+	log.Printf("Waiting for route %s of VPC Routing table %s to be deleted.", routeID, tableID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			route, response, err := sess.GetVPCRoutingTableRoute(sess.NewGetVPCRoutingTableRouteOptions(vpcID, tableID, routeID))
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return route, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error Getting VPC Routing table route (%s): %s\n%s", routeID, err, response)
+			}
+			return route, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for VPC Routing table route (%s) to be deleted: %s", routeID, err)
+	}
+	return nil
+}
+
+// vpcRoutingTableRoutesRun calls fn for 0 to count-1 with at most
+// isRoutingTableRoutesConcurrency calls at a time, and returns the errors of
+// all the calls.
+func vpcRoutingTableRoutesRun(count int, fn func(i int) error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := []string{}
+	sem := make(chan struct{}, isRoutingTableRoutesConcurrency)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// vpcRoutingTableRoutesIdentityKey returns the key of the attributes of a
+// route which cannot be updated.
+func vpcRoutingTableRoutesIdentityKey(route map[string]interface{}) string {
+	return fmt.Sprintf("%s/%s/%s/%s", route[rDestination], route[rZone], route[rNextHop], route[rAction])
+}
+
+// vpcRoutingTableRoutesIndex returns the index of the route of routes with the
+// same name, priority and advertise as route, or -1.
+func vpcRoutingTableRoutesIndex(routes []map[string]interface{}, route map[string]interface{}) int {
+	for i, r := range routes {
+		if r[rName] == route[rName] && r["priority"] == route["priority"] && r["advertise"] == route["advertise"] {
+			return i
+		}
+	}
+	return -1
+}
+
+func vpcRoutingTableRoutesRouteToMap(route vpcv1.Route) map[string]interface{} {
+	routeMap := map[string]interface{}{
+		rName:        flex.StringValue(route.Name),
+		rDestination: flex.StringValue(route.Destination),
+		rAction:      flex.StringValue(route.Action),
+		rNextHop:     "",
+		rZone:        "",
+		"advertise":  false,
+		"priority":   2,
+	}
+	if route.NextHop != nil {
+		nexthop := route.NextHop.(*vpcv1.RouteNextHop)
+		if nexthop.Address != nil {
+			routeMap[rNextHop] = *nexthop.Address
+		}
+		if nexthop.ID != nil {
+			routeMap[rNextHop] = *nexthop.ID
+		}
+	}
+	if route.Zone != nil {
+		routeMap[rZone] = flex.StringValue(route.Zone.Name)
+	}
+	if route.Advertise != nil {
+		routeMap["advertise"] = *route.Advertise
+	}
+	if route.Priority != nil {
+		routeMap["priority"] = int(*route.Priority)
+	}
+	return routeMap
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCRoutingTableRoutes_basic(t *testing.T) {
+	name := fmt.Sprintf("tfvpcroutes-vpc-%d", acctest.RandIntRange(10, 100))
+	routeTableName := fmt.Sprintf("tfvpcroutes-rt-%d", acctest.RandIntRange(10, 100))
+	routeName := fmt.Sprintf("tfvpcroutes-route-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRoutingTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(name, routeTableName, routeName, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.test_routes", "routes.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(name, routeTableName, routeName, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.test_routes", "routes.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(name, routeTableName, routeName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.test_routes", "routes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_vpc_routing_table_routes.test_routes", "routes.*", map[string]string{
+							"name":     routeName + "-1",
+							"priority": "1",
+						}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_routing_table_routes.test_routes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpc_routing_table_routes" {
+			continue
+		}
+
+		idSet := strings.Split(rs.Primary.ID, "/")
+		listVpcRoutingTableRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+			VPCID:          &idSet[0],
+			RoutingTableID: &idSet[1],
+		}
+		routes, _, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			continue
+		}
+		for _, route := range routes.Routes {
+			if route.Creator == nil && route.Origin != nil && *route.Origin == "user" {
+				return fmt.Errorf("routing table route still exists: %s", *route.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesConfig(name, rtName, routeName string, count, priority int) string {
+	routes := ""
+	for i := 1; i <= count; i++ {
+		routes += fmt.Sprintf(`
+  routes {
+    name        = "%s-%d"
+    zone        = "%s"
+    destination = "192.168.%d.0/24"
+    next_hop    = "%s"
+    priority    = %d
+  }`, routeName, i, acc.ISZoneName, i, acc.ISRouteNextHop, priority)
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+  name = "%s"
+}
+resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
+  vpc  = ibm_is_vpc.testacc_vpc.id
+  name = "%s"
+}
+resource "ibm_is_vpc_routing_table_routes" "test_routes" {
+  vpc           = ibm_is_vpc.testacc_vpc.id
+  routing_table = ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table.routing_table
+%s
+}
+`, name, rtName, routes)
+}
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -1343,3 +1344,83 @@ func testAccCheckIBMISVPNGatewayConnectionNullPatchConfig(vpc, subnet, vpnname,
 	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, ikepolicyname, ipsecpolicyname, name, noNullPass, noNullPass)
 
 }
+
+func TestAccIBMISVPNGatewayConnection_dpd(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISVPNGatewayConnectionDpdConfig(vpcname, subnetname, vpnname, name, "restart", 30, 20),
+				ExpectError: regexp.MustCompile("must be at least the dead peer detection interval"),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionDpdConfig(vpcname, subnetname, vpnname, name, "hold", 30, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "action", "hold"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "interval", "30"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "timeout", "120"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionDpdConfig(vpcname, subnetname, vpnname, name, "clear", 15, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "action", "clear"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "interval", "15"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "timeout", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNGatewayConnectionDpdConfig(vpc, subnet, vpnname, name, action string, interval, timeout int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc1" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet1" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc1.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway1" {
+		name = "%s"
+		subnet = "${ibm_is_subnet.testacc_subnet1.id}"
+		timeouts {
+			create = "18m"
+			delete = "18m"
+		}
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection1" {
+		name 				= "%s"
+		vpn_gateway 		= "${ibm_is_vpn_gateway.testacc_VPNGateway1.id}"
+		preshared_key 		= "VPNDemoPassword"
+		peer {
+			address = ibm_is_vpn_gateway.testacc_VPNGateway1.public_ip_address != "0.0.0.0" ? ibm_is_vpn_gateway.testacc_VPNGateway1.public_ip_address : ibm_is_vpn_gateway.testacc_VPNGateway1.public_ip_address2
+		}
+		action 				= "%s"
+		interval 			= %d
+		timeout 			= %d
+	}
+
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, action, interval, timeout)
+
+}
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -57,6 +59,13 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISVPNGatewayConnectionDpdValidate(diff)
+				}),
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isVPNGatewayConnectionName: {
@@ -356,6 +365,17 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 	}
 }
 
+// resourceIBMISVPNGatewayConnectionDpdValidate rejects a dead peer detection
+// timeout lower than the interval.
+func resourceIBMISVPNGatewayConnectionDpdValidate(diff *schema.ResourceDiff) error {
+	interval := diff.Get(isVPNGatewayConnectionDeadPeerDetectionInterval).(int)
+	timeout := diff.Get(isVPNGatewayConnectionDeadPeerDetectionTimeout).(int)
+	if timeout < interval {
+		return fmt.Errorf("dead peer detection timeout (%d) must be at least the dead peer detection interval (%d)", timeout, interval)
+	}
+	return nil
+}
+
 func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	action := "restart, clear, hold, none"
@@ -749,6 +769,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		peerAddress := d.Get(isVPNGatewayConnectionPeerAddress).(string)
 		model := &vpcv1.VPNGatewayConnectionPeerPatch{}
 		model.Address = &peerAddress
+		vpnGatewayConnectionPatchModel.Peer = model
 		hasChanged = true
 	}
 
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Type of the rules relating several arguments of a resource.
+type CrossFieldRuleType int
+
+const (
+	// No more than one of the identifiers can be configured.
+	MutuallyExclusive CrossFieldRuleType = iota
+	// When the first identifier is configured, all the others must be too.
+	RequiredWith
+	// At least one of the identifiers must be configured.
+	AtLeastOneOf
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t CrossFieldRuleType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Use Stringer tool to generate this later.
+func (i CrossFieldRuleType) String() string {
+	return [...]string{"MutuallyExclusive", "RequiredWith", "AtLeastOneOf"}[i]
+}
+
+// CrossFieldRule is used to describe a constraint between several arguments,
+// checked at plan time against the configuration.
+type CrossFieldRule struct {
+	Type CrossFieldRuleType
+
+	// Dot separated path of the nested block holding the identifiers, or "" for
+	// the top level arguments. The rule is checked in each element of the
+	// block, e.g. "parameters" for the toolchain tools.
+	Block string
+
+	// The names of the arguments of the block.
+	Identifiers []string
+}
+
+// InvokeCrossFieldValidator returns the CustomizeDiffFunc checking the cross
+// field rules registered for resourceName, or nil if it doesn't have any.
+func InvokeCrossFieldValidator(resourceName string) schema.CustomizeDiffFunc {
+	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
+	if resourceItem == nil || len(resourceItem.CrossFieldRules) == 0 {
+		return nil
+	}
+	rules := resourceItem.CrossFieldRules
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return ValidateCrossFieldRules(diff.GetRawConfig(), rules)
+	}
+}
+
+// ValidateCrossFieldRules checks the rules against a resource configuration.
+// Arguments whose value is not yet known count as configured.
+func ValidateCrossFieldRules(config cty.Value, rules []CrossFieldRule) error {
+	var errs []error
+	for _, rule := range rules {
+		var steps []string
+		if rule.Block != "" {
+			steps = strings.Split(rule.Block, ".")
+		}
+		for _, block := range crossFieldBlocks(config, "", steps) {
+			if err := rule.validate(block.path, block.value); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type crossFieldBlock struct {
+	path  string
+	value cty.Value
+}
+
+// crossFieldBlocks returns the elements of the nested block found by
+// following steps in value.
+func crossFieldBlocks(value cty.Value, path string, steps []string) []crossFieldBlock {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	valueType := value.Type()
+	if valueType.IsListType() || valueType.IsSetType() || valueType.IsTupleType() {
+		var blocks []crossFieldBlock
+		for i, element := range value.AsValueSlice() {
+			blocks = append(blocks, crossFieldBlocks(element, joinCrossFieldPath(path, strconv.Itoa(i)), steps)...)
+		}
+		return blocks
+	}
+	if len(steps) == 0 {
+		return []crossFieldBlock{{path: path, value: value}}
+	}
+	if !valueType.IsObjectType() || !valueType.HasAttribute(steps[0]) {
+		return nil
+	}
+	return crossFieldBlocks(value.GetAttr(steps[0]), joinCrossFieldPath(path, steps[0]), steps[1:])
+}
+
+func joinCrossFieldPath(path, step string) string {
+	if path == "" {
+		return step
+	}
+	return path + "." + step
+}
+
+// isConfigured reports whether the argument name of block is set in the
+// configuration. Empty collections count as not configured.
+func isConfigured(block cty.Value, name string) bool {
+	if !block.Type().IsObjectType() || !block.Type().HasAttribute(name) {
+		return false
+	}
+	value := block.GetAttr(name)
+	if value.IsNull() {
+		return false
+	}
+	if value.IsKnown() && (value.Type().IsListType() || value.Type().IsSetType() || value.Type().IsMapType()) {
+		return value.LengthInt() > 0
+	}
+	return true
+}
+
+func (rule CrossFieldRule) validate(path string, block cty.Value) error {
+	names := make([]string, len(rule.Identifiers))
+	var configured []string
+	for i, identifier := range rule.Identifiers {
+		names[i] = fmt.Sprintf("%q", joinCrossFieldPath(path, identifier))
+		if isConfigured(block, identifier) {
+			configured = append(configured, names[i])
+		}
+	}
+
+	switch rule.Type {
+	case MutuallyExclusive:
+		if len(configured) > 1 {
+			return fmt.Errorf("only one of %s can be specified, but %s were specified", strings.Join(names, ", "), strings.Join(configured, ", "))
+		}
+	case RequiredWith:
+		if len(names) > 0 && isConfigured(block, rule.Identifiers[0]) && len(configured) < len(names) {
+			return fmt.Errorf("%s: all of %s must be specified", names[0], strings.Join(names, ", "))
+		}
+	case AtLeastOneOf:
+		if len(configured) == 0 {
+			return fmt.Errorf("one of %s must be specified", strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func testCrossFieldConfig(a, b, c cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{"a": a, "b": b, "c": c})
+}
+
+var (
+	crossFieldNull    = cty.NullVal(cty.String)
+	crossFieldSet     = cty.StringVal("value")
+	crossFieldUnknown = cty.UnknownVal(cty.String)
+)
+
+func TestValidateCrossFieldRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     CrossFieldRule
+		config   cty.Value
+		expected string
+	}{
+		{
+			name:   "mutually exclusive, none",
+			rule:   CrossFieldRule{Type: MutuallyExclusive, Identifiers: []string{"a", "b"}},
+			config: testCrossFieldConfig(crossFieldNull, crossFieldNull, crossFieldNull),
+		},
+		{
+			name:   "mutually exclusive, one",
+			rule:   CrossFieldRule{Type: MutuallyExclusive, Identifiers: []string{"a", "b"}},
+			config: testCrossFieldConfig(crossFieldSet, crossFieldNull, crossFieldSet),
+		},
+		{
+			name:     "mutually exclusive, both",
+			rule:     CrossFieldRule{Type: MutuallyExclusive, Identifiers: []string{"a", "b"}},
+			config:   testCrossFieldConfig(crossFieldSet, crossFieldSet, crossFieldNull),
+			expected: `only one of "a", "b" can be specified, but "a", "b" were specified`,
+		},
+		{
+			name:     "mutually exclusive, unknown counts as configured",
+			rule:     CrossFieldRule{Type: MutuallyExclusive, Identifiers: []string{"a", "b"}},
+			config:   testCrossFieldConfig(crossFieldSet, crossFieldUnknown, crossFieldNull),
+			expected: `only one of "a", "b" can be specified`,
+		},
+		{
+			name:   "required with, first not configured",
+			rule:   CrossFieldRule{Type: RequiredWith, Identifiers: []string{"a", "b", "c"}},
+			config: testCrossFieldConfig(crossFieldNull, crossFieldSet, crossFieldNull),
+		},
+		{
+			name:   "required with, all configured",
+			rule:   CrossFieldRule{Type: RequiredWith, Identifiers: []string{"a", "b", "c"}},
+			config: testCrossFieldConfig(crossFieldSet, crossFieldSet, crossFieldUnknown),
+		},
+		{
+			name:     "required with, one missing",
+			rule:     CrossFieldRule{Type: RequiredWith, Identifiers: []string{"a", "b", "c"}},
+			config:   testCrossFieldConfig(crossFieldSet, crossFieldSet, crossFieldNull),
+			expected: `"a": all of "a", "b", "c" must be specified`,
+		},
+		{
+			name:   "at least one of, one",
+			rule:   CrossFieldRule{Type: AtLeastOneOf, Identifiers: []string{"a", "b"}},
+			config: testCrossFieldConfig(crossFieldNull, crossFieldSet, crossFieldNull),
+		},
+		{
+			name:     "at least one of, none",
+			rule:     CrossFieldRule{Type: AtLeastOneOf, Identifiers: []string{"a", "b"}},
+			config:   testCrossFieldConfig(crossFieldNull, crossFieldNull, crossFieldSet),
+			expected: `one of "a", "b" must be specified`,
+		},
+		{
+			name: "at least one of, empty list not configured",
+			rule: CrossFieldRule{Type: AtLeastOneOf, Identifiers: []string{"a", "b"}},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"a": cty.ListValEmpty(cty.String),
+				"b": crossFieldNull,
+			}),
+			expected: `one of "a", "b" must be specified`,
+		},
+		{
+			name: "nested block, each element checked",
+			rule: CrossFieldRule{Type: MutuallyExclusive, Block: "parameters", Identifiers: []string{"a", "b"}},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"parameters": cty.ListVal([]cty.Value{
+					testCrossFieldConfig(crossFieldSet, crossFieldNull, crossFieldNull),
+					testCrossFieldConfig(crossFieldSet, crossFieldSet, crossFieldNull),
+				}),
+			}),
+			expected: `only one of "parameters.1.a", "parameters.1.b" can be specified`,
+		},
+		{
+			name: "nested block, not configured",
+			rule: CrossFieldRule{Type: AtLeastOneOf, Block: "parameters", Identifiers: []string{"a", "b"}},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"parameters": cty.ListValEmpty(cty.Object(map[string]cty.Type{"a": cty.String, "b": cty.String, "c": cty.String})),
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCrossFieldRules(tc.config, []CrossFieldRule{tc.rule})
+			if tc.expected == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected an error containing %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -132,6 +132,19 @@ func ValidateAllowedEnterpriseNameValue() schema.SchemaValidateFunc {
 
 	}
 }
+
+// ValidateSecretReference checks the format of the toolchain secret references
+// used for the sensitive parameters of the tools. Plain text values are
+// accepted, they are hashed by the API.
+func ValidateSecretReference(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if flex.LooksLikeSecretReference(value) && !flex.IsSecretReference(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q isn't a valid secret reference, the expected format is %s", k, flex.SecretReferenceFormat))
+	}
+	return
+}
+
 func ValidateRoutePath(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	//Somehow API allows this
@@ -1169,6 +1182,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Rules between several parameters, checked at plan time.
+	CrossFieldRules []CrossFieldRule
 }
 
 type ValidatorDict struct {
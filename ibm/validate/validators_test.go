@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateSecretReference(t *testing.T) {
+	if _, errs := ValidateSecretReference("{vault::sm-instance.group.secret}", "api_token"); len(errs) != 0 {
+		t.Fatalf("expected a valid secret reference, got %v", errs)
+	}
+	if _, errs := ValidateSecretReference("plain-text-token", "api_token"); len(errs) != 0 {
+		t.Fatalf("expected a plain text value to be accepted, got %v", errs)
+	}
+
+	value := "{vault::my-super-secret-token"
+	_, errs := ValidateSecretReference(value, "api_token")
+	if len(errs) != 1 {
+		t.Fatalf("expected an invalid secret reference error, got %v", errs)
+	}
+	if strings.Contains(errs[0].Error(), value) {
+		t.Fatalf("expected the error not to contain the value, got %q", errs[0])
+	}
+}